@@ -10,6 +10,7 @@ import (
 	"syscall"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 
 	"github.com/openshift/rosa-regional-frontend-api/pkg/config"
 	"github.com/openshift/rosa-regional-frontend-api/pkg/server"
@@ -17,14 +18,17 @@ import (
 
 var (
 	// Config flags
-	logLevel        string
-	logFormat       string
-	maestroURL      string
-	maestroGRPCURL  string
-	allowedAccounts string
-	apiPort         int
-	healthPort      int
-	metricsPort     int
+	logLevel           string
+	logFormat          string
+	maestroURL         string
+	maestroAPIBasePath string
+	maestroGRPCURL     string
+	allowedAccounts    string
+	apiPort            int
+	healthPort         int
+	metricsPort        int
+	rateLimitRPS       float64
+	rateLimitBurst     int
 )
 
 func main() {
@@ -47,38 +51,38 @@ var serveCmd = &cobra.Command{
 }
 
 func init() {
-	serveCmd.Flags().StringVar(&logLevel, "log-level", "info", "Log level (debug, info, warn, error)")
-	serveCmd.Flags().StringVar(&logFormat, "log-format", "json", "Log format (json, text)")
-	serveCmd.Flags().StringVar(&maestroURL, "maestro-url", "http://maestro:8000", "Maestro service base URL")
+	// Flag defaults come from the config defaults so the two cannot drift
+	defaults := config.NewConfig()
+
+	serveCmd.Flags().StringVar(&logLevel, "log-level", defaults.Logging.Level, "Log level (debug, info, warn, error)")
+	serveCmd.Flags().StringVar(&logFormat, "log-format", defaults.Logging.Format, "Log format (json, text)")
+	serveCmd.Flags().StringVar(&maestroURL, "maestro-url", defaults.Maestro.BaseURL, "Maestro service base URL")
+	serveCmd.Flags().StringVar(&maestroAPIBasePath, "maestro-api-base-path", defaults.Maestro.APIBasePath, "Maestro REST API base path")
 	serveCmd.Flags().StringVar(&allowedAccounts, "allowed-accounts", "", "Comma-separated list of allowed AWS account IDs")
-	serveCmd.Flags().StringVar(&maestroGRPCURL, "maestro-grpc-url", "maestro-grpc.maestro-server:8090", "Maestro gRPC service base URL")
-	serveCmd.Flags().IntVar(&apiPort, "api-port", 8000, "API server port")
-	serveCmd.Flags().IntVar(&healthPort, "health-port", 8080, "Health check server port")
-	serveCmd.Flags().IntVar(&metricsPort, "metrics-port", 9090, "Metrics server port")
+	serveCmd.Flags().StringVar(&maestroGRPCURL, "maestro-grpc-url", defaults.Maestro.GRPCBaseURL, "Maestro gRPC service base URL")
+	serveCmd.Flags().IntVar(&apiPort, "api-port", defaults.Server.APIPort, "API server port")
+	serveCmd.Flags().IntVar(&healthPort, "health-port", defaults.Server.HealthPort, "Health check server port")
+	serveCmd.Flags().IntVar(&metricsPort, "metrics-port", defaults.Server.MetricsPort, "Metrics server port")
+	serveCmd.Flags().Float64Var(&rateLimitRPS, "rate-limit-rps", defaults.RateLimit.RequestsPerSecond, "Per-account API requests per second (0 disables rate limiting)")
+	serveCmd.Flags().IntVar(&rateLimitBurst, "rate-limit-burst", defaults.RateLimit.Burst, "Per-account API request burst size")
 
 	rootCmd.AddCommand(serveCmd)
 }
 
 func runServe(cmd *cobra.Command, args []string) error {
+	cfg, err := loadServeConfig(cmd.Flags())
+	if err != nil {
+		return err
+	}
+
 	// Create logger
-	logger := createLogger(logLevel, logFormat)
+	logger := createLogger(cfg.Logging.Level, cfg.Logging.Format)
 
 	logger.Info("starting rosa-regional-frontend-api",
-		"log_level", logLevel,
-		"log_format", logFormat,
+		"log_level", cfg.Logging.Level,
+		"log_format", cfg.Logging.Format,
 	)
 
-	// Create config
-	cfg := config.NewConfig()
-	cfg.Logging.Level = logLevel
-	cfg.Logging.Format = logFormat
-	cfg.Maestro.BaseURL = maestroURL
-	cfg.Maestro.GRPCBaseURL = maestroGRPCURL
-	cfg.AllowedAccounts = parseAllowedAccounts(allowedAccounts)
-	cfg.Server.APIPort = apiPort
-	cfg.Server.HealthPort = healthPort
-	cfg.Server.MetricsPort = metricsPort
-
 	// Create server
 	srv, err := server.New(cfg, logger)
 	if err != nil {
@@ -95,8 +99,11 @@ func runServe(cmd *cobra.Command, args []string) error {
 		"health_port", cfg.Server.HealthPort,
 		"metrics_port", cfg.Server.MetricsPort,
 		"maestro_url", cfg.Maestro.BaseURL,
+		"maestro_api_base_path", cfg.Maestro.APIBasePath,
 		"maestro_grpc_url", cfg.Maestro.GRPCBaseURL,
 		"allowed_accounts_count", len(cfg.AllowedAccounts),
+		"rate_limit_rps", cfg.RateLimit.RequestsPerSecond,
+		"rate_limit_burst", cfg.RateLimit.Burst,
 	)
 
 	if err := srv.Run(ctx); err != nil {
@@ -106,6 +113,61 @@ func runServe(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// loadServeConfig loads config from defaults, CONFIG_FILE and environment
+// variables, applies explicitly set flags on top, and validates the result
+func loadServeConfig(flags *pflag.FlagSet) (*config.Config, error) {
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load config: %w", err)
+	}
+
+	// Explicitly set flags take precedence over file and environment values,
+	// so validation runs only once every layer has been applied
+	applyFlags(flags, cfg)
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+// applyFlags overrides config values with any flags set on the command line
+func applyFlags(flags *pflag.FlagSet, cfg *config.Config) {
+	if flags.Changed("log-level") {
+		cfg.Logging.Level = logLevel
+	}
+	if flags.Changed("log-format") {
+		cfg.Logging.Format = logFormat
+	}
+	if flags.Changed("maestro-url") {
+		cfg.Maestro.BaseURL = maestroURL
+	}
+	if flags.Changed("maestro-api-base-path") {
+		cfg.Maestro.APIBasePath = maestroAPIBasePath
+	}
+	if flags.Changed("maestro-grpc-url") {
+		cfg.Maestro.GRPCBaseURL = maestroGRPCURL
+	}
+	if flags.Changed("allowed-accounts") {
+		cfg.AllowedAccounts = parseAllowedAccounts(allowedAccounts)
+	}
+	if flags.Changed("api-port") {
+		cfg.Server.APIPort = apiPort
+	}
+	if flags.Changed("health-port") {
+		cfg.Server.HealthPort = healthPort
+	}
+	if flags.Changed("metrics-port") {
+		cfg.Server.MetricsPort = metricsPort
+	}
+	if flags.Changed("rate-limit-rps") {
+		cfg.RateLimit.RequestsPerSecond = rateLimitRPS
+	}
+	if flags.Changed("rate-limit-burst") {
+		cfg.RateLimit.Burst = rateLimitBurst
+	}
+}
+
 func createLogger(level, format string) *slog.Logger {
 	var logLevel slog.Level
 	switch level {
@@ -2,7 +2,13 @@ package main
 
 import (
 	"log/slog"
+	"os"
+	"path/filepath"
 	"testing"
+
+	"github.com/spf13/pflag"
+
+	"github.com/openshift/rosa-regional-frontend-api/pkg/config"
 )
 
 func TestCreateLogger(t *testing.T) {
@@ -186,6 +192,9 @@ func TestServeCmd(t *testing.T) {
 		"api-port",
 		"health-port",
 		"metrics-port",
+		"maestro-api-base-path",
+		"rate-limit-rps",
+		"rate-limit-burst",
 	}
 
 	for _, flagName := range expectedFlags {
@@ -195,3 +204,87 @@ func TestServeCmd(t *testing.T) {
 		}
 	}
 }
+
+// restoreFlagGlobals restores the package-level flag variables once the test
+// finishes, since test FlagSets bind flags to them
+func restoreFlagGlobals(t *testing.T) {
+	t.Helper()
+	savedMaestroURL, savedAPIPort, savedLogLevel := maestroURL, apiPort, logLevel
+	t.Cleanup(func() {
+		maestroURL, apiPort, logLevel = savedMaestroURL, savedAPIPort, savedLogLevel
+	})
+}
+
+func TestApplyFlags(t *testing.T) {
+	restoreFlagGlobals(t)
+
+	flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	flags.StringVar(&maestroURL, "maestro-url", "http://maestro:8000", "")
+	flags.IntVar(&apiPort, "api-port", 8000, "")
+	flags.StringVar(&logLevel, "log-level", "info", "")
+
+	if err := flags.Parse([]string{"--maestro-url=https://flag.example.com"}); err != nil {
+		t.Fatalf("failed to parse flags: %v", err)
+	}
+
+	cfg := config.NewConfig()
+	cfg.Server.APIPort = 9500
+	cfg.Logging.Level = "debug"
+
+	applyFlags(flags, cfg)
+
+	if cfg.Maestro.BaseURL != "https://flag.example.com" {
+		t.Errorf("expected explicitly set flag to override config, got %s", cfg.Maestro.BaseURL)
+	}
+
+	if cfg.Server.APIPort != 9500 {
+		t.Errorf("expected unset api-port flag to keep APIPort=9500, got %d", cfg.Server.APIPort)
+	}
+
+	if cfg.Logging.Level != "debug" {
+		t.Errorf("expected unset log-level flag to keep Level=debug, got %s", cfg.Logging.Level)
+	}
+}
+
+func TestLoadServeConfig_FlagOverridesInvalidFile(t *testing.T) {
+	restoreFlagGlobals(t)
+
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte("server:\n  apiPort: 0\n"), 0o600); err != nil {
+		t.Fatalf("failed to write config file: %v", err)
+	}
+	t.Setenv(config.EnvConfigFile, path)
+
+	newFlags := func() *pflag.FlagSet {
+		flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+		flags.IntVar(&apiPort, "api-port", 8000, "")
+		return flags
+	}
+
+	t.Run("flag set", func(t *testing.T) {
+		flags := newFlags()
+		if err := flags.Parse([]string{"--api-port=8000"}); err != nil {
+			t.Fatalf("failed to parse flags: %v", err)
+		}
+
+		cfg, err := loadServeConfig(flags)
+		if err != nil {
+			t.Fatalf("expected flag to override invalid file value, got %v", err)
+		}
+
+		if cfg.Server.APIPort != 8000 {
+			t.Errorf("expected APIPort=8000, got %d", cfg.Server.APIPort)
+		}
+	})
+
+	t.Run("flag unset", func(t *testing.T) {
+		flags := newFlags()
+		if err := flags.Parse(nil); err != nil {
+			t.Fatalf("failed to parse flags: %v", err)
+		}
+
+		if _, err := loadServeConfig(flags); err == nil {
+			t.Error("expected validation error for invalid file value, got nil")
+		}
+	})
+}
@@ -7,25 +7,36 @@ import (
 	"fmt"
 	"io"
 	"log/slog"
+	"maps"
+	"math/rand/v2"
 	"net/http"
 	"net/url"
 	"os"
+	"sort"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/openshift-online/maestro/pkg/api/openapi"
 	"github.com/openshift-online/maestro/pkg/client/cloudevents/grpcsource"
 	"github.com/openshift/rosa-regional-frontend-api/pkg/config"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
 	workv1 "open-cluster-management.io/api/work/v1"
 	workv1client "open-cluster-management.io/api/client/work/clientset/versioned/typed/work/v1"
 	grpcoptions "open-cluster-management.io/sdk-go/pkg/cloudevents/generic/options/grpc"
 )
 
 const (
-	consumersPath       = "/api/maestro/v1/consumers"
-	resourceBundlesPath = "/api/maestro/v1/resource-bundles"
+	// defaultPageSize is the page size used when auto-paginating list calls
+	defaultPageSize = 100
 
-	// /api/maestro/v1/resource-bundles
+	// maxRetryDelay caps the exponential backoff between retries, before
+	// jitter is added
+	maxRetryDelay = 30 * time.Second
+
+	consumersPath       = "/consumers"
+	resourceBundlesPath = "/resource-bundles"
 )
 
 // loggerAdapter adapts slog.Logger to OCM SDK logging.Logger interface
@@ -73,8 +84,12 @@ func (l *loggerAdapter) Fatal(ctx context.Context, format string, args ...interf
 // Client provides access to the Maestro API
 type Client struct {
 	baseURL       string
+	apiBasePath   string
 	grpcBaseURL   string
 	httpClient    *http.Client
+	token         string
+	tokenSource   func(ctx context.Context) (string, error)
+	retry         retryPolicy
 	logger        *slog.Logger
 	grpcOpts      *grpcoptions.GRPCOptions
 	sourceID      string
@@ -92,6 +107,15 @@ func NewClient(cfg config.MaestroConfig, logger *slog.Logger) *Client {
 		openapiCfg.Host = parsedURL.Host
 		openapiCfg.Scheme = parsedURL.Scheme
 	}
+	openapiCfg.HTTPClient = &http.Client{
+		Timeout: cfg.Timeout,
+		Transport: &openapiTransport{
+			base:        http.DefaultTransport,
+			apiBasePath: normalizeAPIBasePath(cfg.APIBasePath),
+			token:       cfg.Token,
+			tokenSource: cfg.TokenSource,
+		},
+	}
 	openapiClient := openapi.NewAPIClient(openapiCfg)
 
 	// Setup gRPC options
@@ -136,10 +160,18 @@ func NewClient(cfg config.MaestroConfig, logger *slog.Logger) *Client {
 
 	return &Client{
 		baseURL:       cfg.BaseURL,
+		apiBasePath:   normalizeAPIBasePath(cfg.APIBasePath),
 		grpcBaseURL:   cfg.GRPCBaseURL,
 		httpClient: &http.Client{
 			Timeout: cfg.Timeout,
 		},
+		token:         cfg.Token,
+		tokenSource:   cfg.TokenSource,
+		retry: retryPolicy{
+			maxAttempts: cfg.RetryMaxAttempts,
+			baseDelay:   cfg.RetryBaseDelay,
+			maxJitter:   cfg.RetryMaxJitter,
+		},
 		logger:        logger,
 		grpcOpts:      grpcOpts,
 		sourceID:      "rosa-regional-frontend-api", // Default source ID
@@ -148,22 +180,193 @@ func NewClient(cfg config.MaestroConfig, logger *slog.Logger) *Client {
 	}
 }
 
-// CreateConsumer creates a new consumer in Maestro
+// normalizeAPIBasePath returns the API base path with exactly one leading
+// slash and no trailing slash, falling back to the default when unset. A path
+// of "/" serves the API from the root of the base URL.
+func normalizeAPIBasePath(path string) string {
+	if path == "" {
+		path = config.DefaultMaestroAPIBasePath
+	}
+	if path = strings.Trim(path, "/"); path == "" {
+		return ""
+	}
+	return "/" + path
+}
+
+// rewriteAPIBasePath replaces the default API base path prefix of path with
+// apiBasePath
+func rewriteAPIBasePath(path, apiBasePath string) string {
+	rest, ok := strings.CutPrefix(path, config.DefaultMaestroAPIBasePath)
+	if !ok || (rest != "" && !strings.HasPrefix(rest, "/")) {
+		return path
+	}
+	return apiBasePath + rest
+}
+
+// resourceURL returns the absolute URL for a Maestro REST resource path
+func (c *Client) resourceURL(path string) string {
+	return c.baseURL + c.apiBasePath + path
+}
+
+// retryPolicy controls how transient Maestro failures are retried
+type retryPolicy struct {
+	maxAttempts int
+	baseDelay   time.Duration
+	maxJitter   time.Duration
+}
+
+// backoff returns the delay before the retry following the given attempt,
+// doubling from baseDelay up to maxRetryDelay
+func (p retryPolicy) backoff(attempt int) time.Duration {
+	delay := min(p.baseDelay, maxRetryDelay)
+	for i := 1; i < attempt && delay < maxRetryDelay; i++ {
+		delay = min(delay*2, maxRetryDelay)
+	}
+	if p.maxJitter > 0 {
+		delay += rand.N(p.maxJitter)
+	}
+	return delay
+}
+
+// isRetryableStatus reports whether a response status indicates a transient
+// gateway failure, as seen while Maestro is being rolled out
+func isRetryableStatus(code int) bool {
+	return code == http.StatusBadGateway ||
+		code == http.StatusServiceUnavailable ||
+		code == http.StatusGatewayTimeout
+}
+
+// do sends the request, retrying connection errors and transient gateway
+// responses with exponential backoff. 4xx responses are never retried, and
+// cancellation of the request context stops any further attempts.
+//
+// Retried requests are not idempotent in general: a POST whose response was
+// lost may already have been applied by Maestro, so callers creating
+// resources must tolerate a conflict on a retried attempt.
+func (c *Client) do(req *http.Request) (*http.Response, error) {
+	resp, _, err := c.doWithAttempts(req)
+	return resp, err
+}
+
+// doWithAttempts is do, additionally returning the number of attempts made
+func (c *Client) doWithAttempts(req *http.Request) (*http.Response, int, error) {
+	ctx := req.Context()
+
+	for attempt := 1; ; attempt++ {
+		attemptReq := req
+		if attempt > 1 {
+			attemptReq = req.Clone(ctx)
+			if req.GetBody != nil {
+				body, err := req.GetBody()
+				if err != nil {
+					return nil, attempt, fmt.Errorf("failed to reset request body: %w", err)
+				}
+				attemptReq.Body = body
+			}
+		}
+
+		resp, err := c.httpClient.Do(attemptReq)
+		if attempt >= c.retry.maxAttempts || ctx.Err() != nil {
+			return resp, attempt, err
+		}
+		if err == nil && !isRetryableStatus(resp.StatusCode) {
+			return resp, attempt, nil
+		}
+
+		logArgs := []any{"method", req.Method, "url", req.URL.String(), "attempt", attempt}
+		if err != nil {
+			logArgs = append(logArgs, "error", err)
+		} else {
+			logArgs = append(logArgs, "status", resp.StatusCode)
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		delay := c.retry.backoff(attempt)
+		c.logger.Debug("retrying Maestro request", append(logArgs, "delay", delay)...)
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, attempt, ctx.Err()
+		}
+	}
+}
+
+// setAuthorization adds a bearer token to the request when the client has been
+// configured with a token or token source
+func (c *Client) setAuthorization(ctx context.Context, req *http.Request) error {
+	return setBearerToken(ctx, req, c.token, c.tokenSource)
+}
+
+// setBearerToken sets the Authorization header from tokenSource, or from token
+// when no token source is configured. Nothing is set if neither yields a token.
+func setBearerToken(ctx context.Context, req *http.Request, token string, tokenSource func(ctx context.Context) (string, error)) error {
+	if tokenSource != nil {
+		var err error
+		token, err = tokenSource(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to obtain bearer token: %w", err)
+		}
+	}
+
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	return nil
+}
+
+// openapiTransport authorizes requests made through the generated OpenAPI
+// client, which the gRPC work client uses for its REST resource lookups. The
+// generated paths hard-code the default API base path, so it is rewritten to
+// the configured one.
+type openapiTransport struct {
+	base        http.RoundTripper
+	apiBasePath string
+	token       string
+	tokenSource func(ctx context.Context) (string, error)
+}
+
+func (t *openapiTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.URL.Path = rewriteAPIBasePath(req.URL.Path, t.apiBasePath)
+	if req.URL.RawPath != "" {
+		req.URL.RawPath = rewriteAPIBasePath(req.URL.RawPath, t.apiBasePath)
+	}
+	if err := setBearerToken(req.Context(), req, t.token, t.tokenSource); err != nil {
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return nil, err
+	}
+
+	return t.base.RoundTrip(req)
+}
+
+// CreateConsumer creates a new consumer in Maestro. If the create is retried
+// and Maestro reports a conflict, an existing consumer with the same name and
+// labels is assumed to be from the earlier attempt and is returned.
 func (c *Client) CreateConsumer(ctx context.Context, req *ConsumerCreateRequest) (*Consumer, error) {
 	body, err := json.Marshal(req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal request: %w", err)
 	}
 
-	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+consumersPath, bytes.NewReader(body))
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, c.resourceURL(consumersPath), bytes.NewReader(body))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
+	if err := c.setAuthorization(ctx, httpReq); err != nil {
+		return nil, err
+	}
 	httpReq.Header.Set("Content-Type", "application/json")
 
 	c.logger.Debug("creating consumer in Maestro", "name", req.Name)
 
-	resp, err := c.httpClient.Do(httpReq)
+	resp, attempts, err := c.doWithAttempts(httpReq)
 	if err != nil {
 		return nil, fmt.Errorf("failed to send request: %w", err)
 	}
@@ -174,6 +377,17 @@ func (c *Client) CreateConsumer(ctx context.Context, req *ConsumerCreateRequest)
 		return nil, fmt.Errorf("failed to read response: %w", err)
 	}
 
+	// A conflict on a retried attempt may mean an earlier attempt created the
+	// consumer but its response was lost. The existing consumer is only
+	// returned if its labels match the request; otherwise the earlier attempt
+	// may never have reached Maestro and this is a genuine name collision.
+	if resp.StatusCode == http.StatusConflict && attempts > 1 && req.Name != "" {
+		if consumer, err := c.getConsumerByName(ctx, req.Name); err == nil && maps.Equal(consumer.Labels, req.Labels) {
+			c.logger.Debug("consumer already created by an earlier attempt", "id", consumer.ID, "name", consumer.Name)
+			return consumer, nil
+		}
+	}
+
 	if resp.StatusCode != http.StatusCreated {
 		var apiErr Error
 		if json.Unmarshal(respBody, &apiErr) == nil && apiErr.Reason != "" {
@@ -192,9 +406,72 @@ func (c *Client) CreateConsumer(ctx context.Context, req *ConsumerCreateRequest)
 	return &consumer, nil
 }
 
+// getConsumerByName returns the consumer with the given name
+func (c *Client) getConsumerByName(ctx context.Context, name string) (*Consumer, error) {
+	search := fmt.Sprintf("name = '%s'", strings.ReplaceAll(name, "'", "''"))
+	list, err := c.listConsumers(ctx, 1, 1, search)
+	if err != nil {
+		return nil, err
+	}
+	if len(list.Items) == 0 {
+		return nil, fmt.Errorf("consumer %q not found", name)
+	}
+	return &list.Items[0], nil
+}
+
 // ListConsumers lists consumers from Maestro with pagination
 func (c *Client) ListConsumers(ctx context.Context, page, size int) (*ConsumerList, error) {
-	u, err := url.Parse(c.baseURL + consumersPath)
+	return c.listConsumers(ctx, page, size, "")
+}
+
+// ListConsumersByLabels lists all consumers whose labels match every entry in
+// labels, following pagination until the full result set has been fetched
+func (c *Client) ListConsumersByLabels(ctx context.Context, labels map[string]string) ([]Consumer, error) {
+	search, err := labelSearch(labels)
+	if err != nil {
+		return nil, err
+	}
+
+	consumerCh, errCh := c.streamConsumers(ctx, defaultPageSize, search)
+
+	var consumers []Consumer
+	for consumer := range consumerCh {
+		consumers = append(consumers, consumer)
+	}
+	if err := <-errCh; err != nil {
+		return nil, err
+	}
+
+	return consumers, nil
+}
+
+// labelSearch translates a label selector into a Maestro search expression
+// using the JSON field operator on the labels column, e.g.
+// {"env": "prod", "region": "us-east-1"} becomes
+// "labels->>'env' = 'prod' and labels->>'region' = 'us-east-1'". Keys are
+// sorted so the query is deterministic. Keys are not escaped, so any key that
+// is not a valid label key is rejected.
+func labelSearch(labels map[string]string) (string, error) {
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		if errs := validation.IsQualifiedName(k); len(errs) > 0 {
+			return "", fmt.Errorf("invalid label key %q: %s", k, strings.Join(errs, "; "))
+		}
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	clauses := make([]string, 0, len(keys))
+	for _, k := range keys {
+		value := strings.ReplaceAll(labels[k], "'", "''")
+		clauses = append(clauses, fmt.Sprintf("labels->>'%s' = '%s'", k, value))
+	}
+
+	return strings.Join(clauses, " and "), nil
+}
+
+func (c *Client) listConsumers(ctx context.Context, page, size int, search string) (*ConsumerList, error) {
+	u, err := url.Parse(c.resourceURL(consumersPath))
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse URL: %w", err)
 	}
@@ -206,16 +483,22 @@ func (c *Client) ListConsumers(ctx context.Context, page, size int) (*ConsumerLi
 	if size > 0 {
 		q.Set("size", strconv.Itoa(size))
 	}
+	if search != "" {
+		q.Set("search", search)
+	}
 	u.RawQuery = q.Encode()
 
 	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
+	if err := c.setAuthorization(ctx, httpReq); err != nil {
+		return nil, err
+	}
 
-	c.logger.Debug("listing consumers from Maestro", "page", page, "size", size)
+	c.logger.Debug("listing consumers from Maestro", "page", page, "size", size, "search", search)
 
-	resp, err := c.httpClient.Do(httpReq)
+	resp, err := c.do(httpReq)
 	if err != nil {
 		return nil, fmt.Errorf("failed to send request: %w", err)
 	}
@@ -244,16 +527,62 @@ func (c *Client) ListConsumers(ctx context.Context, page, size int) (*ConsumerLi
 	return &list, nil
 }
 
+// StreamConsumers lists all consumers from Maestro, fetching pages lazily and
+// emitting each consumer on the returned channel. Both channels are closed once
+// listing finishes; at most one error (including ctx.Err() on cancellation) is
+// sent on the error channel.
+func (c *Client) StreamConsumers(ctx context.Context, pageSize int) (<-chan Consumer, <-chan error) {
+	return c.streamConsumers(ctx, pageSize, "")
+}
+
+func (c *Client) streamConsumers(ctx context.Context, pageSize int, search string) (<-chan Consumer, <-chan error) {
+	consumerCh := make(chan Consumer)
+	errCh := make(chan error, 1)
+
+	go func() {
+		defer close(consumerCh)
+		defer close(errCh)
+
+		received := 0
+		for page := 1; ; page++ {
+			list, err := c.listConsumers(ctx, page, pageSize, search)
+			if err != nil {
+				errCh <- err
+				return
+			}
+
+			for _, consumer := range list.Items {
+				select {
+				case consumerCh <- consumer:
+				case <-ctx.Done():
+					errCh <- ctx.Err()
+					return
+				}
+			}
+
+			received += len(list.Items)
+			if len(list.Items) == 0 || received >= list.Total {
+				return
+			}
+		}
+	}()
+
+	return consumerCh, errCh
+}
+
 // GetConsumer retrieves a consumer by ID from Maestro
 func (c *Client) GetConsumer(ctx context.Context, id string) (*Consumer, error) {
-	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+consumersPath+"/"+id, nil)
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, c.resourceURL(consumersPath)+"/"+id, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
+	if err := c.setAuthorization(ctx, httpReq); err != nil {
+		return nil, err
+	}
 
 	c.logger.Debug("getting consumer from Maestro", "id", id)
 
-	resp, err := c.httpClient.Do(httpReq)
+	resp, err := c.do(httpReq)
 	if err != nil {
 		return nil, fmt.Errorf("failed to send request: %w", err)
 	}
@@ -288,7 +617,7 @@ func (c *Client) GetConsumer(ctx context.Context, id string) (*Consumer, error)
 
 // ListResourceBundles lists resource bundles from Maestro with pagination and optional filters
 func (c *Client) ListResourceBundles(ctx context.Context, page, size int, search, orderBy, fields string) (*ResourceBundleList, error) {
-	u, err := url.Parse(c.baseURL + resourceBundlesPath)
+	u, err := url.Parse(c.resourceURL(resourceBundlesPath))
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse URL: %w", err)
 	}
@@ -315,10 +644,13 @@ func (c *Client) ListResourceBundles(ctx context.Context, page, size int, search
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
+	if err := c.setAuthorization(ctx, httpReq); err != nil {
+		return nil, err
+	}
 
 	c.logger.Debug("listing resource bundles from Maestro", "page", page, "size", size, "search", search)
 
-	resp, err := c.httpClient.Do(httpReq)
+	resp, err := c.do(httpReq)
 	if err != nil {
 		return nil, fmt.Errorf("failed to send request: %w", err)
 	}
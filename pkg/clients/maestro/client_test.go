@@ -3,11 +3,15 @@ package maestro
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"log/slog"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"strconv"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -38,6 +42,542 @@ func TestNewClient(t *testing.T) {
 	if client.logger == nil {
 		t.Error("expected non-nil logger")
 	}
+
+	if client.apiBasePath != config.DefaultMaestroAPIBasePath {
+		t.Errorf("expected apiBasePath=%s, got %s", config.DefaultMaestroAPIBasePath, client.apiBasePath)
+	}
+}
+
+func TestClient_ConfiguredAPIBasePath_OpenAPIClient(t *testing.T) {
+	var path string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]string{"id": "bundle-1", "kind": "ResourceBundle"})
+	}))
+	defer server.Close()
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	cfg := config.MaestroConfig{
+		BaseURL:     server.URL,
+		APIBasePath: "/gateway/maestro/v1",
+		Timeout:     10 * time.Second,
+	}
+	client := NewClient(cfg, logger)
+
+	// The gRPC work client looks up resource bundles through the OpenAPI client
+	ctx := context.Background()
+	if _, _, err := client.openapiClient.DefaultAPI.ApiMaestroV1ResourceBundlesIdGet(ctx, "bundle-1").Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if path != "/gateway/maestro/v1/resource-bundles/bundle-1" {
+		t.Errorf("expected configured base path to be used, got %s", path)
+	}
+}
+
+func TestRewriteAPIBasePath(t *testing.T) {
+	tests := []struct {
+		path        string
+		apiBasePath string
+		expected    string
+	}{
+		{path: "/api/maestro/v1/resource-bundles/a", apiBasePath: "/gateway/v1", expected: "/gateway/v1/resource-bundles/a"},
+		{path: "/api/maestro/v1", apiBasePath: "/gateway/v1", expected: "/gateway/v1"},
+		{path: "/api/maestro/v1/consumers", apiBasePath: "", expected: "/consumers"},
+		{path: "/api/maestro/v10/consumers", apiBasePath: "/gateway/v1", expected: "/api/maestro/v10/consumers"},
+		{path: "/healthcheck", apiBasePath: "/gateway/v1", expected: "/healthcheck"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			if got := rewriteAPIBasePath(tt.path, tt.apiBasePath); got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestNormalizeAPIBasePath(t *testing.T) {
+	tests := []struct {
+		path     string
+		expected string
+	}{
+		{path: "", expected: config.DefaultMaestroAPIBasePath},
+		{path: "/api/maestro/v1", expected: "/api/maestro/v1"},
+		{path: "/api/maestro/v1/", expected: "/api/maestro/v1"},
+		{path: "api/maestro/v1", expected: "/api/maestro/v1"},
+		{path: "/", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			if got := normalizeAPIBasePath(tt.path); got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestClient_ConfiguredAPIBasePath(t *testing.T) {
+	var paths []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.Method+" "+r.URL.Path)
+
+		switch {
+		case r.Method == http.MethodPost:
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(&Consumer{ID: "consumer-1"})
+		case strings.HasSuffix(r.URL.Path, "/consumers/consumer-1"):
+			json.NewEncoder(w).Encode(&Consumer{ID: "consumer-1"})
+		case strings.HasSuffix(r.URL.Path, "/consumers"):
+			json.NewEncoder(w).Encode(&ConsumerList{Kind: "ConsumerList"})
+		default:
+			json.NewEncoder(w).Encode(&ResourceBundleList{Kind: "ResourceBundleList"})
+		}
+	}))
+	defer server.Close()
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	cfg := config.MaestroConfig{
+		BaseURL:     server.URL,
+		APIBasePath: "/gateway/maestro/v1/",
+		Timeout:     10 * time.Second,
+	}
+	client := NewClient(cfg, logger)
+	ctx := context.Background()
+
+	if _, err := client.CreateConsumer(ctx, &ConsumerCreateRequest{Name: "test"}); err != nil {
+		t.Fatalf("CreateConsumer: unexpected error: %v", err)
+	}
+	if _, err := client.ListConsumers(ctx, 1, 10); err != nil {
+		t.Fatalf("ListConsumers: unexpected error: %v", err)
+	}
+	if _, err := client.GetConsumer(ctx, "consumer-1"); err != nil {
+		t.Fatalf("GetConsumer: unexpected error: %v", err)
+	}
+	if _, err := client.ListResourceBundles(ctx, 1, 10, "", "", ""); err != nil {
+		t.Fatalf("ListResourceBundles: unexpected error: %v", err)
+	}
+
+	expected := []string{
+		"POST /gateway/maestro/v1/consumers",
+		"GET /gateway/maestro/v1/consumers",
+		"GET /gateway/maestro/v1/consumers/consumer-1",
+		"GET /gateway/maestro/v1/resource-bundles",
+	}
+
+	if len(paths) != len(expected) {
+		t.Fatalf("expected %d requests, got %d: %v", len(expected), len(paths), paths)
+	}
+
+	for i, path := range expected {
+		if paths[i] != path {
+			t.Errorf("expected request %d to be %q, got %q", i, path, paths[i])
+		}
+	}
+}
+
+func TestClient_BearerToken(t *testing.T) {
+	var calls int
+	tokenSource := func(ctx context.Context) (string, error) {
+		calls++
+		return fmt.Sprintf("token-%d", calls), nil
+	}
+
+	tests := []struct {
+		name     string
+		cfg      config.MaestroConfig
+		expected []string
+	}{
+		{
+			name:     "no token configured",
+			cfg:      config.MaestroConfig{},
+			expected: []string{"", ""},
+		},
+		{
+			name:     "static token",
+			cfg:      config.MaestroConfig{Token: "static-token"},
+			expected: []string{"Bearer static-token", "Bearer static-token"},
+		},
+		{
+			name:     "token source is refreshed per request",
+			cfg:      config.MaestroConfig{Token: "static-token", TokenSource: tokenSource},
+			expected: []string{"Bearer token-1", "Bearer token-2"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var headers []string
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				headers = append(headers, r.Header.Get("Authorization"))
+				w.WriteHeader(http.StatusOK)
+				json.NewEncoder(w).Encode(&ConsumerList{Kind: "ConsumerList"})
+			}))
+			defer server.Close()
+
+			logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+			tt.cfg.BaseURL = server.URL
+			tt.cfg.Timeout = 10 * time.Second
+			client := NewClient(tt.cfg, logger)
+
+			for range tt.expected {
+				if _, err := client.ListConsumers(context.Background(), 1, 10); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+
+			for i, expected := range tt.expected {
+				if headers[i] != expected {
+					t.Errorf("request %d: expected Authorization=%q, got %q", i, expected, headers[i])
+				}
+			}
+		})
+	}
+}
+
+func TestClient_BearerToken_OpenAPIClient(t *testing.T) {
+	var header string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header.Get("Authorization")
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]string{"id": "bundle-1", "kind": "ResourceBundle"})
+	}))
+	defer server.Close()
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	cfg := config.MaestroConfig{
+		BaseURL: server.URL,
+		Timeout: 10 * time.Second,
+		TokenSource: func(ctx context.Context) (string, error) {
+			return "fresh-token", nil
+		},
+	}
+	client := NewClient(cfg, logger)
+
+	// The gRPC work client looks up resource bundles through the OpenAPI client
+	ctx := context.Background()
+	if _, _, err := client.openapiClient.DefaultAPI.ApiMaestroV1ResourceBundlesIdGet(ctx, "bundle-1").Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if header != "Bearer fresh-token" {
+		t.Errorf("expected Authorization=%q, got %q", "Bearer fresh-token", header)
+	}
+}
+
+func TestClient_BearerToken_TokenSourceError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("expected no request to be sent")
+	}))
+	defer server.Close()
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	cfg := config.MaestroConfig{
+		BaseURL: server.URL,
+		Timeout: 10 * time.Second,
+		TokenSource: func(ctx context.Context) (string, error) {
+			return "", errors.New("token expired")
+		},
+	}
+	client := NewClient(cfg, logger)
+
+	_, err := client.GetConsumer(context.Background(), "consumer-1")
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+
+	if !strings.Contains(err.Error(), "token expired") {
+		t.Errorf("expected error to contain 'token expired', got %v", err)
+	}
+}
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	p := retryPolicy{baseDelay: 200 * time.Millisecond}
+
+	tests := []struct {
+		attempt  int
+		expected time.Duration
+	}{
+		{attempt: 1, expected: 200 * time.Millisecond},
+		{attempt: 2, expected: 400 * time.Millisecond},
+		{attempt: 4, expected: 1600 * time.Millisecond},
+		{attempt: 10, expected: maxRetryDelay},
+		{attempt: 100, expected: maxRetryDelay},
+	}
+
+	for _, tt := range tests {
+		if got := p.backoff(tt.attempt); got != tt.expected {
+			t.Errorf("attempt %d: expected %v, got %v", tt.attempt, tt.expected, got)
+		}
+	}
+}
+
+func TestClient_Retry(t *testing.T) {
+	tests := []struct {
+		name             string
+		responses        []int
+		maxAttempts      int
+		expectedAttempts int
+		expectErr        bool
+	}{
+		{
+			name:             "retries 503 then succeeds",
+			responses:        []int{http.StatusServiceUnavailable, http.StatusOK},
+			maxAttempts:      3,
+			expectedAttempts: 2,
+		},
+		{
+			name:             "retries 502 and 504",
+			responses:        []int{http.StatusBadGateway, http.StatusGatewayTimeout, http.StatusOK},
+			maxAttempts:      3,
+			expectedAttempts: 3,
+		},
+		{
+			name:             "gives up after max attempts",
+			responses:        []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusServiceUnavailable},
+			maxAttempts:      3,
+			expectedAttempts: 3,
+			expectErr:        true,
+		},
+		{
+			name:             "never retries 4xx",
+			responses:        []int{http.StatusBadRequest, http.StatusOK},
+			maxAttempts:      3,
+			expectedAttempts: 1,
+			expectErr:        true,
+		},
+		{
+			name:             "does not retry 500",
+			responses:        []int{http.StatusInternalServerError, http.StatusOK},
+			maxAttempts:      3,
+			expectedAttempts: 1,
+			expectErr:        true,
+		},
+		{
+			name:             "retries disabled",
+			responses:        []int{http.StatusServiceUnavailable, http.StatusOK},
+			maxAttempts:      0,
+			expectedAttempts: 1,
+			expectErr:        true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var attempts int
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				status := tt.responses[attempts]
+				attempts++
+
+				var req ConsumerCreateRequest
+				if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Name != "test-consumer" {
+					t.Errorf("attempt %d: expected request body to be resent, got %+v (err=%v)", attempts, req, err)
+				}
+
+				if status != http.StatusOK {
+					w.WriteHeader(status)
+					return
+				}
+				w.WriteHeader(http.StatusCreated)
+				json.NewEncoder(w).Encode(&Consumer{ID: "consumer-1"})
+			}))
+			defer server.Close()
+
+			logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+			cfg := config.MaestroConfig{
+				BaseURL:          server.URL,
+				Timeout:          10 * time.Second,
+				RetryMaxAttempts: tt.maxAttempts,
+				RetryBaseDelay:   time.Millisecond,
+				RetryMaxJitter:   time.Millisecond,
+			}
+			client := NewClient(cfg, logger)
+
+			_, err := client.CreateConsumer(context.Background(), &ConsumerCreateRequest{Name: "test-consumer"})
+			if tt.expectErr && err == nil {
+				t.Error("expected error, got nil")
+			}
+			if !tt.expectErr && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+
+			if attempts != tt.expectedAttempts {
+				t.Errorf("expected %d attempts, got %d", tt.expectedAttempts, attempts)
+			}
+		})
+	}
+}
+
+func TestClient_CreateConsumer_RetriedConflict(t *testing.T) {
+	tests := []struct {
+		name        string
+		responses   []int
+		existing    []Consumer
+		expectErr   bool
+		expectedReq []string
+	}{
+		{
+			name:        "conflict after retry returns existing consumer",
+			responses:   []int{http.StatusGatewayTimeout, http.StatusConflict},
+			existing:    []Consumer{{ID: "consumer-1", Name: "test-consumer", Labels: map[string]string{"region": "us-east-1"}}},
+			expectedReq: []string{"POST", "POST", "GET"},
+		},
+		{
+			name:        "conflict after retry with different labels is an error",
+			responses:   []int{http.StatusGatewayTimeout, http.StatusConflict},
+			existing:    []Consumer{{ID: "consumer-1", Name: "test-consumer", Labels: map[string]string{"region": "eu-west-1"}}},
+			expectErr:   true,
+			expectedReq: []string{"POST", "POST", "GET"},
+		},
+		{
+			name:        "conflict on first attempt is an error",
+			responses:   []int{http.StatusConflict},
+			existing:    []Consumer{{ID: "consumer-1", Name: "test-consumer"}},
+			expectErr:   true,
+			expectedReq: []string{"POST"},
+		},
+		{
+			name:        "conflict after retry without match is an error",
+			responses:   []int{http.StatusGatewayTimeout, http.StatusConflict},
+			expectErr:   true,
+			expectedReq: []string{"POST", "POST", "GET"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests []string
+			var posts int
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests = append(requests, r.Method)
+
+				if r.Method == http.MethodGet {
+					if search := r.URL.Query().Get("search"); search != "name = 'test-consumer'" {
+						t.Errorf("expected search by name, got %q", search)
+					}
+					json.NewEncoder(w).Encode(&ConsumerList{Kind: "ConsumerList", Items: tt.existing, Total: len(tt.existing)})
+					return
+				}
+
+				status := tt.responses[posts]
+				posts++
+				w.WriteHeader(status)
+				if status == http.StatusConflict {
+					json.NewEncoder(w).Encode(&Error{Kind: "Error", Code: "maestro-409", Reason: "consumer already exists"})
+				}
+			}))
+			defer server.Close()
+
+			logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+			cfg := config.MaestroConfig{
+				BaseURL:          server.URL,
+				Timeout:          10 * time.Second,
+				RetryMaxAttempts: 3,
+				RetryBaseDelay:   time.Millisecond,
+			}
+			client := NewClient(cfg, logger)
+
+			consumer, err := client.CreateConsumer(context.Background(), &ConsumerCreateRequest{
+				Name:   "test-consumer",
+				Labels: map[string]string{"region": "us-east-1"},
+			})
+			if tt.expectErr {
+				if err == nil {
+					t.Error("expected error, got nil")
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if consumer.ID != "consumer-1" {
+					t.Errorf("expected consumer ID=consumer-1, got %s", consumer.ID)
+				}
+			}
+
+			if strings.Join(requests, ",") != strings.Join(tt.expectedReq, ",") {
+				t.Errorf("expected requests %v, got %v", tt.expectedReq, requests)
+			}
+		})
+	}
+}
+
+func TestClient_Retry_ConnectionError(t *testing.T) {
+	var attempts int
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			// Drop the connection without a response
+			conn, _, err := w.(http.Hijacker).Hijack()
+			if err != nil {
+				t.Fatalf("failed to hijack connection: %v", err)
+			}
+			conn.Close()
+			return
+		}
+		json.NewEncoder(w).Encode(&Consumer{ID: "consumer-1"})
+	}))
+	defer server.Close()
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	cfg := config.MaestroConfig{
+		BaseURL:          server.URL,
+		Timeout:          10 * time.Second,
+		RetryMaxAttempts: 3,
+		RetryBaseDelay:   time.Millisecond,
+	}
+	client := NewClient(cfg, logger)
+
+	consumer, err := client.GetConsumer(context.Background(), "consumer-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if consumer.ID != "consumer-1" {
+		t.Errorf("expected consumer-1, got %s", consumer.ID)
+	}
+
+	if attempts != 2 {
+		t.Errorf("expected 2 attempts, got %d", attempts)
+	}
+}
+
+func TestClient_Retry_ContextCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var attempts int
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		cancel()
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	cfg := config.MaestroConfig{
+		BaseURL:          server.URL,
+		Timeout:          10 * time.Second,
+		RetryMaxAttempts: 5,
+		RetryBaseDelay:   time.Minute,
+	}
+	client := NewClient(cfg, logger)
+
+	_, err := client.ListConsumers(ctx, 1, 10)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+
+	if attempts != 1 {
+		t.Errorf("expected 1 attempt, got %d", attempts)
+	}
 }
 
 func TestClient_CreateConsumer_Success(t *testing.T) {
@@ -320,6 +860,237 @@ func TestClient_ListConsumers_MaestroError(t *testing.T) {
 	}
 }
 
+func TestClient_StreamConsumers_AllPages(t *testing.T) {
+	consumers := []Consumer{
+		{ID: "consumer-1", Name: "test-1"},
+		{ID: "consumer-2", Name: "test-2"},
+		{ID: "consumer-3", Name: "test-3"},
+		{ID: "consumer-4", Name: "test-4"},
+		{ID: "consumer-5", Name: "test-5"},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		size, _ := strconv.Atoi(r.URL.Query().Get("size"))
+		if size != 2 {
+			t.Errorf("expected size=2, got %d", size)
+		}
+
+		start := (page - 1) * size
+		end := min(start+size, len(consumers))
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(&ConsumerList{
+			Kind:  "ConsumerList",
+			Page:  page,
+			Size:  end - start,
+			Total: len(consumers),
+			Items: consumers[start:end],
+		})
+	}))
+	defer server.Close()
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	cfg := config.MaestroConfig{
+		BaseURL: server.URL,
+		Timeout: 10 * time.Second,
+	}
+	client := NewClient(cfg, logger)
+
+	consumerCh, errCh := client.StreamConsumers(context.Background(), 2)
+
+	var got []string
+	for consumer := range consumerCh {
+		got = append(got, consumer.ID)
+	}
+
+	if err := <-errCh; err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(got) != len(consumers) {
+		t.Fatalf("expected %d consumers, got %d", len(consumers), len(got))
+	}
+
+	for i, consumer := range consumers {
+		if got[i] != consumer.ID {
+			t.Errorf("expected consumer %d to be %s, got %s", i, consumer.ID, got[i])
+		}
+	}
+}
+
+func TestClient_StreamConsumers_Cancellation(t *testing.T) {
+	var requests atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(&ConsumerList{
+			Kind:  "ConsumerList",
+			Page:  1,
+			Size:  2,
+			Total: 10,
+			Items: []Consumer{
+				{ID: "consumer-1"},
+				{ID: "consumer-2"},
+			},
+		})
+	}))
+	defer server.Close()
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	cfg := config.MaestroConfig{
+		BaseURL: server.URL,
+		Timeout: 10 * time.Second,
+	}
+	client := NewClient(cfg, logger)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	consumerCh, errCh := client.StreamConsumers(ctx, 2)
+
+	first := <-consumerCh
+	if first.ID != "consumer-1" {
+		t.Errorf("expected consumer-1, got %s", first.ID)
+	}
+
+	cancel()
+
+	// The stream must stop without emitting the rest of the page or fetching further pages
+	err := <-errCh
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+
+	if _, ok := <-consumerCh; ok {
+		t.Error("expected consumer channel to be closed")
+	}
+
+	if n := requests.Load(); n != 1 {
+		t.Errorf("expected 1 page request, got %d", n)
+	}
+}
+
+func TestClient_ListConsumersByLabels(t *testing.T) {
+	var searches []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		searches = append(searches, r.URL.Query().Get("search"))
+
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		items := []Consumer{{ID: fmt.Sprintf("consumer-%d", page)}}
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(&ConsumerList{
+			Kind:  "ConsumerList",
+			Page:  page,
+			Size:  len(items),
+			Total: 2,
+			Items: items,
+		})
+	}))
+	defer server.Close()
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	cfg := config.MaestroConfig{
+		BaseURL: server.URL,
+		Timeout: 10 * time.Second,
+	}
+	client := NewClient(cfg, logger)
+
+	consumers, err := client.ListConsumersByLabels(context.Background(), map[string]string{
+		"region":       "us-east-1",
+		"cluster_type": "management",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(consumers) != 2 {
+		t.Fatalf("expected 2 consumers, got %d", len(consumers))
+	}
+
+	expectedSearch := "labels->>'cluster_type' = 'management' and labels->>'region' = 'us-east-1'"
+	for i, search := range searches {
+		if search != expectedSearch {
+			t.Errorf("request %d: expected search=%q, got %q", i, expectedSearch, search)
+		}
+	}
+}
+
+func TestLabelSearch(t *testing.T) {
+	tests := []struct {
+		name      string
+		labels    map[string]string
+		expected  string
+		expectErr bool
+	}{
+		{
+			name:     "no labels",
+			labels:   nil,
+			expected: "",
+		},
+		{
+			name:     "single label",
+			labels:   map[string]string{"region": "us-east-1"},
+			expected: "labels->>'region' = 'us-east-1'",
+		},
+		{
+			name:     "multiple labels are sorted by key",
+			labels:   map[string]string{"region": "us-east-1", "env": "prod"},
+			expected: "labels->>'env' = 'prod' and labels->>'region' = 'us-east-1'",
+		},
+		{
+			name:     "single quotes are escaped",
+			labels:   map[string]string{"owner": "o'brien"},
+			expected: "labels->>'owner' = 'o''brien'",
+		},
+		{
+			name:      "key with spaces and quotes is rejected",
+			labels:    map[string]string{"env = 'prod' or name": "x"},
+			expectErr: true,
+		},
+		{
+			name:      "empty key is rejected",
+			labels:    map[string]string{"": "x"},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := labelSearch(tt.labels)
+			if tt.expectErr {
+				if err == nil {
+					t.Errorf("expected error, got search %q", got)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestClient_ListConsumersByLabels_InvalidKey(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("expected no request for an invalid label key")
+	}))
+	defer server.Close()
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	client := NewClient(config.MaestroConfig{BaseURL: server.URL, Timeout: 10 * time.Second}, logger)
+
+	if _, err := client.ListConsumersByLabels(context.Background(), map[string]string{"bad key": "x"}); err == nil {
+		t.Error("expected error, got nil")
+	}
+}
+
 func TestClient_GetConsumer_Success(t *testing.T) {
 	now := time.Now()
 	expectedConsumer := &Consumer{
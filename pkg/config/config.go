@@ -1,11 +1,18 @@
 package config
 
-import "time"
+import (
+	"context"
+	"time"
+)
+
+// DefaultMaestroAPIBasePath is the path prefix of the Maestro REST API
+const DefaultMaestroAPIBasePath = "/api/maestro/v1"
 
 type Config struct {
 	Server          ServerConfig
 	Maestro         MaestroConfig
 	Logging         LoggingConfig
+	RateLimit       RateLimitConfig
 	AllowedAccounts []string
 }
 
@@ -23,8 +30,32 @@ type ServerConfig struct {
 
 type MaestroConfig struct {
 	BaseURL     string
+	APIBasePath string
 	GRPCBaseURL string
 	Timeout     time.Duration
+	// Token is a static bearer token sent with every Maestro REST request
+	Token string
+	// TokenSource, when set, is called per request to obtain a bearer token
+	// and takes precedence over Token
+	TokenSource func(ctx context.Context) (string, error)
+	// RetryMaxAttempts is the total number of attempts made for a REST
+	// request that fails with a connection error or a 502/503/504; values
+	// below 2 disable retries
+	RetryMaxAttempts int
+	// RetryBaseDelay is the delay before the first retry, doubled on each
+	// subsequent attempt
+	RetryBaseDelay time.Duration
+	// RetryMaxJitter is the upper bound of the random delay added to each
+	// backoff
+	RetryMaxJitter time.Duration
+}
+
+// RateLimitConfig configures per-account API rate limiting. A zero
+// RequestsPerSecond disables rate limiting.
+type RateLimitConfig struct {
+	RequestsPerSecond float64
+	Burst             int
+	IdleTimeout       time.Duration
 }
 
 type LoggingConfig struct {
@@ -46,13 +77,22 @@ func NewConfig() *Config {
 			ShutdownTimeout:    30 * time.Second,
 		},
 		Maestro: MaestroConfig{
-			BaseURL:     "http://maestro:8000",
-			GRPCBaseURL: "maestro-grpc.maestro-server:8090",
-			Timeout:     30 * time.Second,
+			BaseURL:          "http://maestro:8000",
+			APIBasePath:      DefaultMaestroAPIBasePath,
+			GRPCBaseURL:      "maestro-grpc.maestro-server:8090",
+			Timeout:          30 * time.Second,
+			RetryMaxAttempts: 3,
+			RetryBaseDelay:   200 * time.Millisecond,
+			RetryMaxJitter:   100 * time.Millisecond,
 		},
 		Logging: LoggingConfig{
 			Level:  "info",
 			Format: "json",
 		},
+		RateLimit: RateLimitConfig{
+			RequestsPerSecond: 10,
+			Burst:             20,
+			IdleTimeout:       10 * time.Minute,
+		},
 	}
 }
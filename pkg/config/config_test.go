@@ -46,10 +46,22 @@ func TestNewConfig(t *testing.T) {
 		t.Errorf("expected Maestro.BaseURL=http://maestro:8000, got %s", cfg.Maestro.BaseURL)
 	}
 
+	if cfg.Maestro.APIBasePath != "/api/maestro/v1" {
+		t.Errorf("expected Maestro.APIBasePath=/api/maestro/v1, got %s", cfg.Maestro.APIBasePath)
+	}
+
 	if cfg.Maestro.Timeout != 30*time.Second {
 		t.Errorf("expected Maestro.Timeout=30s, got %v", cfg.Maestro.Timeout)
 	}
 
+	if cfg.Maestro.RetryMaxAttempts != 3 {
+		t.Errorf("expected Maestro.RetryMaxAttempts=3, got %d", cfg.Maestro.RetryMaxAttempts)
+	}
+
+	if cfg.Maestro.RetryBaseDelay != 200*time.Millisecond {
+		t.Errorf("expected Maestro.RetryBaseDelay=200ms, got %v", cfg.Maestro.RetryBaseDelay)
+	}
+
 	// Test Logging config defaults
 	if cfg.Logging.Level != "info" {
 		t.Errorf("expected Logging.Level=info, got %s", cfg.Logging.Level)
@@ -59,6 +71,19 @@ func TestNewConfig(t *testing.T) {
 		t.Errorf("expected Logging.Format=json, got %s", cfg.Logging.Format)
 	}
 
+	// Test RateLimit config defaults
+	if cfg.RateLimit.RequestsPerSecond != 10 {
+		t.Errorf("expected RateLimit.RequestsPerSecond=10, got %v", cfg.RateLimit.RequestsPerSecond)
+	}
+
+	if cfg.RateLimit.Burst != 20 {
+		t.Errorf("expected RateLimit.Burst=20, got %d", cfg.RateLimit.Burst)
+	}
+
+	if cfg.RateLimit.IdleTimeout != 10*time.Minute {
+		t.Errorf("expected RateLimit.IdleTimeout=10m, got %v", cfg.RateLimit.IdleTimeout)
+	}
+
 	// Test that AllowedAccounts defaults to empty/nil
 	if cfg.AllowedAccounts != nil && len(cfg.AllowedAccounts) != 0 {
 		t.Errorf("expected empty AllowedAccounts, got %d items", len(cfg.AllowedAccounts))
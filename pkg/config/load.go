@@ -0,0 +1,259 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"sigs.k8s.io/yaml"
+)
+
+// EnvConfigFile is the environment variable naming an optional YAML or JSON
+// configuration file
+const EnvConfigFile = "CONFIG_FILE"
+
+// FieldError describes a single invalid or missing configuration field
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+// Error aggregates every problem found while loading or validating
+// configuration
+type Error struct {
+	Fields []FieldError
+}
+
+func (e *Error) Error() string {
+	problems := make([]string, 0, len(e.Fields))
+	for _, f := range e.Fields {
+		problems = append(problems, fmt.Sprintf("%s: %s", f.Field, f.Message))
+	}
+	return "invalid configuration: " + strings.Join(problems, "; ")
+}
+
+func (e *Error) add(field, format string, args ...any) {
+	e.Fields = append(e.Fields, FieldError{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+// fileConfig is the on-disk configuration format. All fields are optional;
+// unset fields keep their default values. Durations use Go duration syntax.
+type fileConfig struct {
+	Server *struct {
+		APIBindAddress     *string `json:"apiBindAddress"`
+		APIPort            *int    `json:"apiPort"`
+		HealthBindAddress  *string `json:"healthBindAddress"`
+		HealthPort         *int    `json:"healthPort"`
+		MetricsBindAddress *string `json:"metricsBindAddress"`
+		MetricsPort        *int    `json:"metricsPort"`
+		ShutdownTimeout    *string `json:"shutdownTimeout"`
+	} `json:"server"`
+	Maestro *struct {
+		BaseURL          *string `json:"baseURL"`
+		APIBasePath      *string `json:"apiBasePath"`
+		GRPCBaseURL      *string `json:"grpcBaseURL"`
+		Timeout          *string `json:"timeout"`
+		RetryMaxAttempts *int    `json:"retryMaxAttempts"`
+		RetryBaseDelay   *string `json:"retryBaseDelay"`
+		RetryMaxJitter   *string `json:"retryMaxJitter"`
+	} `json:"maestro"`
+	Logging *struct {
+		Level  *string `json:"level"`
+		Format *string `json:"format"`
+	} `json:"logging"`
+	RateLimit *struct {
+		RequestsPerSecond *float64 `json:"requestsPerSecond"`
+		Burst             *int     `json:"burst"`
+		IdleTimeout       *string  `json:"idleTimeout"`
+	} `json:"rateLimit"`
+	AllowedAccounts []string `json:"allowedAccounts"`
+}
+
+// LoadConfig builds the configuration by layering the defaults from
+// NewConfig, the optional file named by CONFIG_FILE, and environment variable
+// overrides, with environment variables taking precedence. All values that
+// fail to parse are returned together as an *Error. The result is not
+// validated; callers apply any remaining overrides and then call Validate.
+func LoadConfig() (*Config, error) {
+	return loadConfig(os.Getenv)
+}
+
+// loadConfig implements LoadConfig, reading environment variables through
+// getenv
+func loadConfig(getenv func(string) string) (*Config, error) {
+	cfg := NewConfig()
+	errs := &Error{}
+
+	if path := getenv(EnvConfigFile); path != "" {
+		if err := cfg.applyFile(path, errs); err != nil {
+			return nil, err
+		}
+	}
+
+	cfg.applyEnv(getenv, errs)
+
+	if len(errs.Fields) > 0 {
+		return nil, errs
+	}
+
+	return cfg, nil
+}
+
+// applyFile overlays the values set in a YAML or JSON configuration file. An
+// unreadable or unparseable file is returned as an error; invalid field values
+// are recorded in errs.
+func (c *Config) applyFile(path string, errs *Error) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	var fc fileConfig
+	if err := yaml.UnmarshalStrict(data, &fc); err != nil {
+		return fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+
+	if s := fc.Server; s != nil {
+		setString(&c.Server.APIBindAddress, s.APIBindAddress)
+		setInt(&c.Server.APIPort, s.APIPort)
+		setString(&c.Server.HealthBindAddress, s.HealthBindAddress)
+		setInt(&c.Server.HealthPort, s.HealthPort)
+		setString(&c.Server.MetricsBindAddress, s.MetricsBindAddress)
+		setInt(&c.Server.MetricsPort, s.MetricsPort)
+		setDuration(&c.Server.ShutdownTimeout, s.ShutdownTimeout, "server.shutdownTimeout", errs)
+	}
+
+	if m := fc.Maestro; m != nil {
+		setString(&c.Maestro.BaseURL, m.BaseURL)
+		setString(&c.Maestro.APIBasePath, m.APIBasePath)
+		setString(&c.Maestro.GRPCBaseURL, m.GRPCBaseURL)
+		setDuration(&c.Maestro.Timeout, m.Timeout, "maestro.timeout", errs)
+		setInt(&c.Maestro.RetryMaxAttempts, m.RetryMaxAttempts)
+		setDuration(&c.Maestro.RetryBaseDelay, m.RetryBaseDelay, "maestro.retryBaseDelay", errs)
+		setDuration(&c.Maestro.RetryMaxJitter, m.RetryMaxJitter, "maestro.retryMaxJitter", errs)
+	}
+
+	if l := fc.Logging; l != nil {
+		setString(&c.Logging.Level, l.Level)
+		setString(&c.Logging.Format, l.Format)
+	}
+
+	if r := fc.RateLimit; r != nil {
+		if r.RequestsPerSecond != nil {
+			c.RateLimit.RequestsPerSecond = *r.RequestsPerSecond
+		}
+		setInt(&c.RateLimit.Burst, r.Burst)
+		setDuration(&c.RateLimit.IdleTimeout, r.IdleTimeout, "rateLimit.idleTimeout", errs)
+	}
+
+	if fc.AllowedAccounts != nil {
+		c.AllowedAccounts = fc.AllowedAccounts
+	}
+
+	return nil
+}
+
+// applyEnv overlays values from environment variables, recording values that
+// fail to parse in errs
+func (c *Config) applyEnv(getenv func(string) string, errs *Error) {
+	strVars := []struct {
+		name  string
+		field *string
+	}{
+		{"API_BIND_ADDRESS", &c.Server.APIBindAddress},
+		{"HEALTH_BIND_ADDRESS", &c.Server.HealthBindAddress},
+		{"METRICS_BIND_ADDRESS", &c.Server.MetricsBindAddress},
+		{"MAESTRO_BASE_URL", &c.Maestro.BaseURL},
+		{"MAESTRO_API_BASE_PATH", &c.Maestro.APIBasePath},
+		{"MAESTRO_GRPC_BASE_URL", &c.Maestro.GRPCBaseURL},
+		{"MAESTRO_TOKEN", &c.Maestro.Token},
+		{"LOG_LEVEL", &c.Logging.Level},
+		{"LOG_FORMAT", &c.Logging.Format},
+	}
+	for _, env := range strVars {
+		if v := getenv(env.name); v != "" {
+			*env.field = v
+		}
+	}
+
+	intVars := []struct {
+		name  string
+		field *int
+	}{
+		{"API_PORT", &c.Server.APIPort},
+		{"HEALTH_PORT", &c.Server.HealthPort},
+		{"METRICS_PORT", &c.Server.MetricsPort},
+		{"MAESTRO_RETRY_MAX_ATTEMPTS", &c.Maestro.RetryMaxAttempts},
+		{"RATE_LIMIT_BURST", &c.RateLimit.Burst},
+	}
+	for _, env := range intVars {
+		if v := getenv(env.name); v != "" {
+			n, err := strconv.Atoi(v)
+			if err != nil {
+				errs.add(env.name, "must be an integer, got %q", v)
+				continue
+			}
+			*env.field = n
+		}
+	}
+
+	durationVars := []struct {
+		name  string
+		field *time.Duration
+	}{
+		{"SHUTDOWN_TIMEOUT", &c.Server.ShutdownTimeout},
+		{"MAESTRO_TIMEOUT", &c.Maestro.Timeout},
+		{"MAESTRO_RETRY_BASE_DELAY", &c.Maestro.RetryBaseDelay},
+		{"MAESTRO_RETRY_MAX_JITTER", &c.Maestro.RetryMaxJitter},
+		{"RATE_LIMIT_IDLE_TIMEOUT", &c.RateLimit.IdleTimeout},
+	}
+	for _, env := range durationVars {
+		if v := getenv(env.name); v != "" {
+			setDuration(env.field, &v, env.name, errs)
+		}
+	}
+
+	if v := getenv("RATE_LIMIT_RPS"); v != "" {
+		rps, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			errs.add("RATE_LIMIT_RPS", "must be a number, got %q", v)
+		} else {
+			c.RateLimit.RequestsPerSecond = rps
+		}
+	}
+
+	if v := getenv("ALLOWED_ACCOUNTS"); v != "" {
+		c.AllowedAccounts = nil
+		for _, acc := range strings.Split(v, ",") {
+			if acc = strings.TrimSpace(acc); acc != "" {
+				c.AllowedAccounts = append(c.AllowedAccounts, acc)
+			}
+		}
+	}
+}
+
+func setString(dst *string, v *string) {
+	if v != nil {
+		*dst = *v
+	}
+}
+
+func setInt(dst *int, v *int) {
+	if v != nil {
+		*dst = *v
+	}
+}
+
+func setDuration(dst *time.Duration, v *string, field string, errs *Error) {
+	if v == nil {
+		return
+	}
+	d, err := time.ParseDuration(*v)
+	if err != nil {
+		errs.add(field, "must be a duration such as 30s, got %q", *v)
+		return
+	}
+	*dst = d
+}
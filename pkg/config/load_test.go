@@ -0,0 +1,224 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func writeConfigFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("failed to write config file: %v", err)
+	}
+	return path
+}
+
+// fakeEnv returns a getenv function backed by vars, so tests are not affected
+// by the process environment
+func fakeEnv(vars map[string]string) func(string) string {
+	return func(name string) string {
+		return vars[name]
+	}
+}
+
+func TestLoadConfig_Defaults(t *testing.T) {
+	cfg, err := loadConfig(fakeEnv(nil))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !reflect.DeepEqual(cfg, NewConfig()) {
+		t.Errorf("expected defaults, got %+v", cfg)
+	}
+}
+
+func TestLoadConfig_File(t *testing.T) {
+	tests := []struct {
+		name    string
+		file    string
+		content string
+	}{
+		{
+			name: "yaml",
+			file: "config.yaml",
+			content: `
+server:
+  apiPort: 9000
+  shutdownTimeout: 10s
+maestro:
+  baseURL: https://maestro.example.com
+  timeout: 5s
+logging:
+  level: debug
+allowedAccounts:
+  - "123456789012"
+`,
+		},
+		{
+			name: "json",
+			file: "config.json",
+			content: `{
+  "server": {"apiPort": 9000, "shutdownTimeout": "10s"},
+  "maestro": {"baseURL": "https://maestro.example.com", "timeout": "5s"},
+  "logging": {"level": "debug"},
+  "allowedAccounts": ["123456789012"]
+}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := map[string]string{EnvConfigFile: writeConfigFile(t, tt.file, tt.content)}
+
+			cfg, err := loadConfig(fakeEnv(env))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if cfg.Server.APIPort != 9000 {
+				t.Errorf("expected APIPort=9000, got %d", cfg.Server.APIPort)
+			}
+
+			if cfg.Server.ShutdownTimeout != 10*time.Second {
+				t.Errorf("expected ShutdownTimeout=10s, got %v", cfg.Server.ShutdownTimeout)
+			}
+
+			if cfg.Maestro.BaseURL != "https://maestro.example.com" {
+				t.Errorf("expected Maestro.BaseURL=https://maestro.example.com, got %s", cfg.Maestro.BaseURL)
+			}
+
+			if cfg.Maestro.Timeout != 5*time.Second {
+				t.Errorf("expected Maestro.Timeout=5s, got %v", cfg.Maestro.Timeout)
+			}
+
+			if cfg.Logging.Level != "debug" {
+				t.Errorf("expected Logging.Level=debug, got %s", cfg.Logging.Level)
+			}
+
+			if !reflect.DeepEqual(cfg.AllowedAccounts, []string{"123456789012"}) {
+				t.Errorf("expected AllowedAccounts=[123456789012], got %v", cfg.AllowedAccounts)
+			}
+
+			// Fields not present in the file keep their defaults
+			if cfg.Server.HealthPort != 8080 {
+				t.Errorf("expected HealthPort=8080, got %d", cfg.Server.HealthPort)
+			}
+
+			if cfg.Logging.Format != "json" {
+				t.Errorf("expected Logging.Format=json, got %s", cfg.Logging.Format)
+			}
+		})
+	}
+}
+
+func TestLoadConfig_EnvOverridesFile(t *testing.T) {
+	env := map[string]string{
+		EnvConfigFile: writeConfigFile(t, "config.yaml", `
+server:
+  apiPort: 9000
+maestro:
+  baseURL: https://file.example.com
+`),
+		"API_PORT":         "9500",
+		"MAESTRO_BASE_URL": "https://env.example.com",
+		"MAESTRO_TIMEOUT":  "15s",
+		"RATE_LIMIT_RPS":   "2.5",
+		"ALLOWED_ACCOUNTS": "123456789012, 987654321098",
+	}
+
+	cfg, err := loadConfig(fakeEnv(env))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if cfg.Server.APIPort != 9500 {
+		t.Errorf("expected APIPort=9500, got %d", cfg.Server.APIPort)
+	}
+
+	if cfg.Maestro.BaseURL != "https://env.example.com" {
+		t.Errorf("expected Maestro.BaseURL=https://env.example.com, got %s", cfg.Maestro.BaseURL)
+	}
+
+	if cfg.Maestro.Timeout != 15*time.Second {
+		t.Errorf("expected Maestro.Timeout=15s, got %v", cfg.Maestro.Timeout)
+	}
+
+	if cfg.RateLimit.RequestsPerSecond != 2.5 {
+		t.Errorf("expected RateLimit.RequestsPerSecond=2.5, got %v", cfg.RateLimit.RequestsPerSecond)
+	}
+
+	if !reflect.DeepEqual(cfg.AllowedAccounts, []string{"123456789012", "987654321098"}) {
+		t.Errorf("expected two allowed accounts, got %v", cfg.AllowedAccounts)
+	}
+}
+
+func TestLoadConfig_InvalidValues(t *testing.T) {
+	env := map[string]string{
+		EnvConfigFile: writeConfigFile(t, "config.yaml", `
+server:
+  shutdownTimeout: soon
+maestro:
+  grpcBaseURL: ""
+`),
+		"API_PORT":        "eighty",
+		"MAESTRO_TIMEOUT": "30",
+	}
+
+	_, err := loadConfig(fakeEnv(env))
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+
+	var cfgErr *Error
+	if !errors.As(err, &cfgErr) {
+		t.Fatalf("expected *Error, got %T", err)
+	}
+
+	var fields []string
+	for _, f := range cfgErr.Fields {
+		fields = append(fields, f.Field)
+	}
+
+	expected := []string{"server.shutdownTimeout", "API_PORT", "MAESTRO_TIMEOUT"}
+	if !reflect.DeepEqual(fields, expected) {
+		t.Errorf("expected errors for %v, got %v", expected, fields)
+	}
+}
+
+func TestLoadConfig_DoesNotValidate(t *testing.T) {
+	env := map[string]string{
+		EnvConfigFile: writeConfigFile(t, "config.yaml", "server:\n  apiPort: 0\n"),
+	}
+
+	cfg, err := loadConfig(fakeEnv(env))
+	if err != nil {
+		t.Fatalf("expected semantically invalid values to load without error, got %v", err)
+	}
+
+	if cfg.Server.APIPort != 0 {
+		t.Errorf("expected APIPort=0, got %d", cfg.Server.APIPort)
+	}
+}
+
+func TestLoadConfig_FileErrors(t *testing.T) {
+	t.Run("missing file", func(t *testing.T) {
+		env := map[string]string{EnvConfigFile: filepath.Join(t.TempDir(), "missing.yaml")}
+
+		if _, err := loadConfig(fakeEnv(env)); err == nil {
+			t.Error("expected error for missing file, got nil")
+		}
+	})
+
+	t.Run("unknown field", func(t *testing.T) {
+		env := map[string]string{EnvConfigFile: writeConfigFile(t, "config.yaml", "server:\n  apiProt: 9000\n")}
+
+		if _, err := loadConfig(fakeEnv(env)); err == nil {
+			t.Error("expected error for unknown field, got nil")
+		}
+	})
+}
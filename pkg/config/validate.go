@@ -0,0 +1,82 @@
+package config
+
+import (
+	"math"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+var (
+	validLogLevels  = []string{"debug", "info", "warn", "error"}
+	validLogFormats = []string{"json", "text"}
+)
+
+// Validate checks the configuration for invalid or missing values and returns
+// an *Error describing every problem found, or nil if the configuration is
+// valid
+func (c *Config) Validate() error {
+	errs := &Error{}
+
+	validatePort(errs, "server.apiPort", c.Server.APIPort)
+	validatePort(errs, "server.healthPort", c.Server.HealthPort)
+	validatePort(errs, "server.metricsPort", c.Server.MetricsPort)
+	if c.Server.ShutdownTimeout <= 0 {
+		errs.add("server.shutdownTimeout", "must be positive, got %v", c.Server.ShutdownTimeout)
+	}
+
+	if c.Maestro.BaseURL == "" {
+		errs.add("maestro.baseURL", "is required")
+	} else if u, err := url.Parse(c.Maestro.BaseURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		errs.add("maestro.baseURL", "must be an absolute http or https URL, got %q", c.Maestro.BaseURL)
+	}
+	if c.Maestro.APIBasePath != "" && !strings.HasPrefix(c.Maestro.APIBasePath, "/") {
+		errs.add("maestro.apiBasePath", "must start with /, got %q", c.Maestro.APIBasePath)
+	}
+	if c.Maestro.GRPCBaseURL == "" {
+		errs.add("maestro.grpcBaseURL", "is required")
+	}
+	if c.Maestro.Timeout <= 0 {
+		errs.add("maestro.timeout", "must be positive, got %v", c.Maestro.Timeout)
+	}
+	if c.Maestro.RetryMaxAttempts < 0 {
+		errs.add("maestro.retryMaxAttempts", "must not be negative, got %d", c.Maestro.RetryMaxAttempts)
+	}
+	if c.Maestro.RetryBaseDelay < 0 {
+		errs.add("maestro.retryBaseDelay", "must not be negative, got %v", c.Maestro.RetryBaseDelay)
+	}
+	if c.Maestro.RetryMaxJitter < 0 {
+		errs.add("maestro.retryMaxJitter", "must not be negative, got %v", c.Maestro.RetryMaxJitter)
+	}
+
+	if !slices.Contains(validLogLevels, c.Logging.Level) {
+		errs.add("logging.level", "must be one of %v, got %q", validLogLevels, c.Logging.Level)
+	}
+	if !slices.Contains(validLogFormats, c.Logging.Format) {
+		errs.add("logging.format", "must be one of %v, got %q", validLogFormats, c.Logging.Format)
+	}
+
+	if math.IsNaN(c.RateLimit.RequestsPerSecond) || math.IsInf(c.RateLimit.RequestsPerSecond, 0) {
+		errs.add("rateLimit.requestsPerSecond", "must be a finite number, got %v", c.RateLimit.RequestsPerSecond)
+	} else if c.RateLimit.RequestsPerSecond < 0 {
+		errs.add("rateLimit.requestsPerSecond", "must not be negative, got %v", c.RateLimit.RequestsPerSecond)
+	} else if c.RateLimit.RequestsPerSecond > 0 {
+		if c.RateLimit.Burst < 1 {
+			errs.add("rateLimit.burst", "must be at least 1 when rate limiting is enabled, got %d", c.RateLimit.Burst)
+		}
+		if c.RateLimit.IdleTimeout <= 0 {
+			errs.add("rateLimit.idleTimeout", "must be positive when rate limiting is enabled, got %v", c.RateLimit.IdleTimeout)
+		}
+	}
+
+	if len(errs.Fields) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func validatePort(errs *Error, field string, port int) {
+	if port < 1 || port > 65535 {
+		errs.add(field, "must be between 1 and 65535, got %d", port)
+	}
+}
@@ -0,0 +1,148 @@
+package config
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestConfig_Validate_Defaults(t *testing.T) {
+	if err := NewConfig().Validate(); err != nil {
+		t.Errorf("expected default config to be valid, got %v", err)
+	}
+}
+
+func TestConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name           string
+		modify         func(cfg *Config)
+		expectedFields []string
+	}{
+		{
+			name:           "zero API port",
+			modify:         func(cfg *Config) { cfg.Server.APIPort = 0 },
+			expectedFields: []string{"server.apiPort"},
+		},
+		{
+			name:           "port out of range",
+			modify:         func(cfg *Config) { cfg.Server.MetricsPort = 70000 },
+			expectedFields: []string{"server.metricsPort"},
+		},
+		{
+			name:           "non-positive shutdown timeout",
+			modify:         func(cfg *Config) { cfg.Server.ShutdownTimeout = 0 },
+			expectedFields: []string{"server.shutdownTimeout"},
+		},
+		{
+			name:           "empty Maestro base URL",
+			modify:         func(cfg *Config) { cfg.Maestro.BaseURL = "" },
+			expectedFields: []string{"maestro.baseURL"},
+		},
+		{
+			name:           "Maestro base URL without scheme",
+			modify:         func(cfg *Config) { cfg.Maestro.BaseURL = "maestro:8000" },
+			expectedFields: []string{"maestro.baseURL"},
+		},
+		{
+			name:           "unparseable Maestro base URL",
+			modify:         func(cfg *Config) { cfg.Maestro.BaseURL = "http://[::1" },
+			expectedFields: []string{"maestro.baseURL"},
+		},
+		{
+			name:           "relative Maestro API base path",
+			modify:         func(cfg *Config) { cfg.Maestro.APIBasePath = "api/maestro/v1" },
+			expectedFields: []string{"maestro.apiBasePath"},
+		},
+		{
+			name:   "empty Maestro API base path",
+			modify: func(cfg *Config) { cfg.Maestro.APIBasePath = "" },
+		},
+		{
+			name:           "empty Maestro gRPC URL",
+			modify:         func(cfg *Config) { cfg.Maestro.GRPCBaseURL = "" },
+			expectedFields: []string{"maestro.grpcBaseURL"},
+		},
+		{
+			name:           "negative Maestro timeout",
+			modify:         func(cfg *Config) { cfg.Maestro.Timeout = -time.Second },
+			expectedFields: []string{"maestro.timeout"},
+		},
+		{
+			name:           "negative retry settings",
+			modify:         func(cfg *Config) { cfg.Maestro.RetryMaxAttempts = -1; cfg.Maestro.RetryBaseDelay = -time.Second },
+			expectedFields: []string{"maestro.retryMaxAttempts", "maestro.retryBaseDelay"},
+		},
+		{
+			name:           "unknown log level",
+			modify:         func(cfg *Config) { cfg.Logging.Level = "verbose" },
+			expectedFields: []string{"logging.level"},
+		},
+		{
+			name:           "unknown log format",
+			modify:         func(cfg *Config) { cfg.Logging.Format = "xml" },
+			expectedFields: []string{"logging.format"},
+		},
+		{
+			name:           "NaN rate limit",
+			modify:         func(cfg *Config) { cfg.RateLimit.RequestsPerSecond = math.NaN() },
+			expectedFields: []string{"rateLimit.requestsPerSecond"},
+		},
+		{
+			name:           "infinite rate limit",
+			modify:         func(cfg *Config) { cfg.RateLimit.RequestsPerSecond = math.Inf(1) },
+			expectedFields: []string{"rateLimit.requestsPerSecond"},
+		},
+		{
+			name:           "rate limit without burst",
+			modify:         func(cfg *Config) { cfg.RateLimit.Burst = 0 },
+			expectedFields: []string{"rateLimit.burst"},
+		},
+		{
+			name: "rate limiting disabled ignores burst",
+			modify: func(cfg *Config) {
+				cfg.RateLimit.RequestsPerSecond = 0
+				cfg.RateLimit.Burst = 0
+			},
+		},
+		{
+			name: "multiple problems are aggregated",
+			modify: func(cfg *Config) {
+				cfg.Server.APIPort = -1
+				cfg.Maestro.BaseURL = ""
+				cfg.Logging.Level = ""
+			},
+			expectedFields: []string{"server.apiPort", "maestro.baseURL", "logging.level"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := NewConfig()
+			tt.modify(cfg)
+
+			err := cfg.Validate()
+			if len(tt.expectedFields) == 0 {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+
+			var cfgErr *Error
+			if !errors.As(err, &cfgErr) {
+				t.Fatalf("expected *Error, got %T (%v)", err, err)
+			}
+
+			var fields []string
+			for _, f := range cfgErr.Fields {
+				fields = append(fields, f.Field)
+			}
+
+			if !reflect.DeepEqual(fields, tt.expectedFields) {
+				t.Errorf("expected errors for %v, got %v", tt.expectedFields, fields)
+			}
+		})
+	}
+}
@@ -1,14 +1,37 @@
 package handlers
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"sort"
+	"sync"
 	"sync/atomic"
+	"time"
 )
 
+// defaultCheckTimeout bounds how long readiness checks may take in total
+const defaultCheckTimeout = 2 * time.Second
+
+// ReadinessCheck verifies that a dependency is reachable
+type ReadinessCheck func(ctx context.Context) error
+
 // HealthHandler handles health check endpoints
 type HealthHandler struct {
-	ready *atomic.Bool
+	ready        *atomic.Bool
+	checkTimeout time.Duration
+	now          func() time.Time
+
+	mu      sync.RWMutex
+	checks  map[string]ReadinessCheck
+	workers map[string]*workerHeartbeat
+}
+
+// workerHeartbeat tracks the last heartbeat of a background worker
+type workerHeartbeat struct {
+	interval time.Duration
+	lastBeat time.Time
 }
 
 // NewHealthHandler creates a new HealthHandler
@@ -16,7 +39,11 @@ func NewHealthHandler() *HealthHandler {
 	ready := &atomic.Bool{}
 	ready.Store(true)
 	return &HealthHandler{
-		ready: ready,
+		ready:        ready,
+		checkTimeout: defaultCheckTimeout,
+		now:          time.Now,
+		checks:       make(map[string]ReadinessCheck),
+		workers:      make(map[string]*workerHeartbeat),
 	}
 }
 
@@ -25,12 +52,76 @@ func (h *HealthHandler) SetReady(ready bool) {
 	h.ready.Store(ready)
 }
 
+// AddCheck registers a named readiness check that must pass for the service
+// to report ready. Registering a check with an existing name replaces it.
+func (h *HealthHandler) AddCheck(name string, check ReadinessCheck) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.checks[name] = check
+}
+
+// RegisterWorker registers a background worker that is expected to call Beat
+// at least once every interval. Liveness fails once a registered worker
+// misses its interval. A non-positive interval is rejected, since the worker
+// would be reported as stalled immediately.
+func (h *HealthHandler) RegisterWorker(name string, interval time.Duration) error {
+	if interval <= 0 {
+		return fmt.Errorf("worker %q: heartbeat interval must be positive, got %v", name, interval)
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.workers[name] = &workerHeartbeat{
+		interval: interval,
+		lastBeat: h.now(),
+	}
+
+	return nil
+}
+
+// Beat records a heartbeat for a registered background worker
+func (h *HealthHandler) Beat(name string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if worker, ok := h.workers[name]; ok {
+		worker.lastBeat = h.now()
+	}
+}
+
 // Liveness handles GET /live
 func (h *HealthHandler) Liveness(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
+
+	if stalled := h.stalledWorkers(); len(stalled) > 0 {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"status":          "unavailable",
+			"stalled_workers": stalled,
+		})
+		return
+	}
+
 	json.NewEncoder(w).Encode(map[string]string{"status": "ok"})
 }
 
+// stalledWorkers returns the names of registered workers that have not sent a
+// heartbeat within their interval
+func (h *HealthHandler) stalledWorkers() []string {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	now := h.now()
+	var stalled []string
+	for name, worker := range h.workers {
+		if now.Sub(worker.lastBeat) > worker.interval {
+			stalled = append(stalled, name)
+		}
+	}
+	sort.Strings(stalled)
+
+	return stalled
+}
+
 // Readiness handles GET /ready
 func (h *HealthHandler) Readiness(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
@@ -41,5 +132,62 @@ func (h *HealthHandler) Readiness(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if failed := h.runChecks(r.Context()); len(failed) > 0 {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"status":        "unavailable",
+			"failed_checks": failed,
+		})
+		return
+	}
+
 	json.NewEncoder(w).Encode(map[string]string{"status": "ok"})
 }
+
+// runChecks runs all registered readiness checks concurrently and returns the
+// error message of each failed check keyed by check name. Checks that have not
+// returned once the timeout expires are reported as timed out.
+func (h *HealthHandler) runChecks(ctx context.Context) map[string]string {
+	h.mu.RLock()
+	checks := make(map[string]ReadinessCheck, len(h.checks))
+	for name, check := range h.checks {
+		checks[name] = check
+	}
+	h.mu.RUnlock()
+
+	if len(checks) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, h.checkTimeout)
+	defer cancel()
+
+	type result struct {
+		name string
+		err  error
+	}
+	results := make(chan result, len(checks))
+	for name, check := range checks {
+		go func() {
+			results <- result{name: name, err: check(ctx)}
+		}()
+	}
+
+	failed := make(map[string]string)
+	for range len(checks) {
+		select {
+		case res := <-results:
+			delete(checks, res.name)
+			if res.err != nil {
+				failed[res.name] = res.err.Error()
+			}
+		case <-ctx.Done():
+			for name := range checks {
+				failed[name] = "check timed out"
+			}
+			return failed
+		}
+	}
+
+	return failed
+}
@@ -0,0 +1,204 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestHealthHandler_Readiness_NoChecks(t *testing.T) {
+	handler := NewHealthHandler()
+
+	w := httptest.NewRecorder()
+	handler.Readiness(w, httptest.NewRequest(http.MethodGet, "/ready", nil))
+
+	if w.Code != http.StatusOK {
+		t.Errorf("expected status 200, got %d", w.Code)
+	}
+}
+
+func TestHealthHandler_Readiness_Checks(t *testing.T) {
+	tests := []struct {
+		name           string
+		checks         map[string]ReadinessCheck
+		expectedStatus int
+		expectedFailed map[string]string
+	}{
+		{
+			name: "all checks pass",
+			checks: map[string]ReadinessCheck{
+				"dynamodb": func(ctx context.Context) error { return nil },
+				"maestro":  func(ctx context.Context) error { return nil },
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "one check fails",
+			checks: map[string]ReadinessCheck{
+				"dynamodb": func(ctx context.Context) error { return nil },
+				"maestro":  func(ctx context.Context) error { return errors.New("connection refused") },
+			},
+			expectedStatus: http.StatusServiceUnavailable,
+			expectedFailed: map[string]string{"maestro": "connection refused"},
+		},
+		{
+			name: "check times out",
+			checks: map[string]ReadinessCheck{
+				"dynamodb": func(ctx context.Context) error { return nil },
+				"slow": func(ctx context.Context) error {
+					time.Sleep(time.Second)
+					return nil
+				},
+			},
+			expectedStatus: http.StatusServiceUnavailable,
+			expectedFailed: map[string]string{"slow": "check timed out"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := NewHealthHandler()
+			handler.checkTimeout = 50 * time.Millisecond
+			for name, check := range tt.checks {
+				handler.AddCheck(name, check)
+			}
+
+			w := httptest.NewRecorder()
+			handler.Readiness(w, httptest.NewRequest(http.MethodGet, "/ready", nil))
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("expected status %d, got %d", tt.expectedStatus, w.Code)
+			}
+
+			var resp struct {
+				Status       string            `json:"status"`
+				FailedChecks map[string]string `json:"failed_checks"`
+			}
+			if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+
+			if len(resp.FailedChecks) != len(tt.expectedFailed) {
+				t.Errorf("expected failed checks %v, got %v", tt.expectedFailed, resp.FailedChecks)
+			}
+			for name, msg := range tt.expectedFailed {
+				if resp.FailedChecks[name] != msg {
+					t.Errorf("expected failed check %s=%q, got %q", name, msg, resp.FailedChecks[name])
+				}
+			}
+		})
+	}
+}
+
+func TestHealthHandler_Readiness_NotReadySkipsChecks(t *testing.T) {
+	handler := NewHealthHandler()
+	handler.SetReady(false)
+
+	called := false
+	handler.AddCheck("maestro", func(ctx context.Context) error {
+		called = true
+		return nil
+	})
+
+	w := httptest.NewRecorder()
+	handler.Readiness(w, httptest.NewRequest(http.MethodGet, "/ready", nil))
+
+	if w.Code != http.StatusServiceUnavailable {
+		t.Errorf("expected status 503, got %d", w.Code)
+	}
+
+	if called {
+		t.Error("expected checks not to run while not ready")
+	}
+}
+
+func TestHealthHandler_Liveness_Workers(t *testing.T) {
+	handler := NewHealthHandler()
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	handler.now = func() time.Time { return now }
+
+	liveness := func() (int, []string) {
+		w := httptest.NewRecorder()
+		handler.Liveness(w, httptest.NewRequest(http.MethodGet, "/live", nil))
+
+		var resp struct {
+			Status         string   `json:"status"`
+			StalledWorkers []string `json:"stalled_workers"`
+		}
+		if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		return w.Code, resp.StalledWorkers
+	}
+
+	// No workers registered
+	if code, _ := liveness(); code != http.StatusOK {
+		t.Errorf("expected status 200 with no workers, got %d", code)
+	}
+
+	for name, interval := range map[string]time.Duration{"configmap-watcher": time.Minute, "cache-sweeper": 5 * time.Minute} {
+		if err := handler.RegisterWorker(name, interval); err != nil {
+			t.Fatalf("failed to register worker %s: %v", name, err)
+		}
+	}
+
+	now = now.Add(30 * time.Second)
+	if code, _ := liveness(); code != http.StatusOK {
+		t.Errorf("expected status 200 within interval, got %d", code)
+	}
+
+	// The watcher keeps beating, the sweeper stops
+	now = now.Add(50 * time.Second)
+	handler.Beat("configmap-watcher")
+	if code, _ := liveness(); code != http.StatusOK {
+		t.Errorf("expected status 200 after heartbeat, got %d", code)
+	}
+
+	now = now.Add(5 * time.Minute)
+	handler.Beat("configmap-watcher")
+	code, stalled := liveness()
+	if code != http.StatusServiceUnavailable {
+		t.Errorf("expected status 503 for stalled worker, got %d", code)
+	}
+	if len(stalled) != 1 || stalled[0] != "cache-sweeper" {
+		t.Errorf("expected stalled_workers=[cache-sweeper], got %v", stalled)
+	}
+
+	handler.Beat("cache-sweeper")
+	if code, _ := liveness(); code != http.StatusOK {
+		t.Errorf("expected status 200 after recovery, got %d", code)
+	}
+}
+
+func TestHealthHandler_RegisterWorker_NonPositiveInterval(t *testing.T) {
+	handler := NewHealthHandler()
+
+	for _, interval := range []time.Duration{0, -time.Second} {
+		if err := handler.RegisterWorker("bad-worker", interval); err == nil {
+			t.Errorf("expected error for interval %v, got nil", interval)
+		}
+	}
+
+	w := httptest.NewRecorder()
+	handler.Liveness(w, httptest.NewRequest(http.MethodGet, "/live", nil))
+
+	if w.Code != http.StatusOK {
+		t.Errorf("expected status 200 after rejected registration, got %d", w.Code)
+	}
+}
+
+func TestHealthHandler_Beat_UnregisteredWorker(t *testing.T) {
+	handler := NewHealthHandler()
+	handler.Beat("unknown")
+
+	w := httptest.NewRecorder()
+	handler.Liveness(w, httptest.NewRequest(http.MethodGet, "/live", nil))
+
+	if w.Code != http.StatusOK {
+		t.Errorf("expected status 200, got %d", w.Code)
+	}
+}
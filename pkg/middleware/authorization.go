@@ -24,6 +24,20 @@ func NewAuthorization(allowedAccounts []string, logger *slog.Logger) *Authorizat
 	}
 }
 
+// IsValidAccountID reports whether id is a well-formed AWS account ID, which is
+// exactly 12 decimal digits
+func IsValidAccountID(id string) bool {
+	if len(id) != 12 {
+		return false
+	}
+	for _, c := range id {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
 // RequireAllowedAccount verifies that the AWS account is in the allowlist
 func (a *Authorization) RequireAllowedAccount(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -36,6 +50,12 @@ func (a *Authorization) RequireAllowedAccount(next http.Handler) http.Handler {
 			return
 		}
 
+		if !IsValidAccountID(accountID) {
+			a.logger.Warn("invalid account ID in request", "account_id", accountID)
+			a.writeError(w, http.StatusBadRequest, "invalid-account-id", "Account ID must be a 12-digit AWS account ID")
+			return
+		}
+
 		if _, allowed := a.allowedAccounts[accountID]; !allowed {
 			a.logger.Warn("account not allowed", "account_id", accountID)
 			a.writeError(w, http.StatusForbidden, "account-not-allowed", "account not allowed")
@@ -47,6 +67,11 @@ func (a *Authorization) RequireAllowedAccount(next http.Handler) http.Handler {
 }
 
 func (a *Authorization) writeError(w http.ResponseWriter, status int, code, reason string) {
+	writeError(w, status, code, reason)
+}
+
+// writeError writes the standard JSON error envelope
+func writeError(w http.ResponseWriter, status int, code, reason string) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(status)
 
@@ -158,6 +158,69 @@ func TestAuthorization_RequireAllowedAccount_EmptyAccountID(t *testing.T) {
 	}
 }
 
+func TestAuthorization_RequireAllowedAccount_InvalidAccountID(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	auth := NewAuthorization([]string{"123456789012", "not-an-account"}, logger)
+
+	for _, accountID := range []string{"12345", "1234567890123", "not-an-account", "12345678901a"} {
+		t.Run(accountID, func(t *testing.T) {
+			nextCalled := false
+			handler := auth.RequireAllowedAccount(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				nextCalled = true
+				w.WriteHeader(http.StatusOK)
+			}))
+
+			req := httptest.NewRequest(http.MethodGet, "/test", nil)
+			ctx := context.WithValue(req.Context(), ContextKeyAccountID, accountID)
+			req = req.WithContext(ctx)
+
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req)
+
+			if nextCalled {
+				t.Error("expected next handler NOT to be called")
+			}
+
+			if w.Code != http.StatusBadRequest {
+				t.Errorf("expected status 400, got %d", w.Code)
+			}
+
+			var errorResp map[string]interface{}
+			if err := json.NewDecoder(w.Body).Decode(&errorResp); err != nil {
+				t.Fatalf("failed to decode error response: %v", err)
+			}
+
+			if errorResp["code"] != "invalid-account-id" {
+				t.Errorf("expected code=invalid-account-id, got %v", errorResp["code"])
+			}
+		})
+	}
+}
+
+func TestIsValidAccountID(t *testing.T) {
+	tests := []struct {
+		id    string
+		valid bool
+	}{
+		{id: "123456789012", valid: true},
+		{id: "000000000000", valid: true},
+		{id: "", valid: false},
+		{id: "12345678901", valid: false},
+		{id: "1234567890123", valid: false},
+		{id: "12345678901a", valid: false},
+		{id: " 23456789012", valid: false},
+		{id: "１２３４５６７８９０１２", valid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			if got := IsValidAccountID(tt.id); got != tt.valid {
+				t.Errorf("IsValidAccountID(%q) = %v, want %v", tt.id, got, tt.valid)
+			}
+		})
+	}
+}
+
 func TestAuthorization_RequireAllowedAccount_MultipleAllowedAccounts(t *testing.T) {
 	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
 	allowedAccounts := []string{
@@ -3,6 +3,8 @@ package middleware
 import (
 	"context"
 	"net/http"
+
+	"github.com/google/uuid"
 )
 
 type contextKey string
@@ -29,7 +31,9 @@ const (
 	HeaderRequestID = "X-Amz-Request-Id"
 )
 
-// Identity extracts AWS identity headers and adds them to the request context
+// Identity extracts AWS identity headers and adds them to the request context.
+// If the request carries no request ID, a UUID is generated; the request ID is
+// echoed back in the response headers either way.
 func Identity(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
@@ -50,9 +54,12 @@ func Identity(next http.Handler) http.Handler {
 			ctx = context.WithValue(ctx, ContextKeySourceIP, sourceIP)
 		}
 
-		if requestID := r.Header.Get(HeaderRequestID); requestID != "" {
-			ctx = context.WithValue(ctx, ContextKeyRequestID, requestID)
+		requestID := r.Header.Get(HeaderRequestID)
+		if requestID == "" {
+			requestID = uuid.NewString()
 		}
+		ctx = context.WithValue(ctx, ContextKeyRequestID, requestID)
+		w.Header().Set(HeaderRequestID, requestID)
 
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
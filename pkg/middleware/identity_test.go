@@ -5,6 +5,8 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+
+	"github.com/google/uuid"
 )
 
 func TestIdentity_AllHeaders(t *testing.T) {
@@ -69,8 +71,8 @@ func TestIdentity_NoHeaders(t *testing.T) {
 		}
 
 		requestID := GetRequestID(ctx)
-		if requestID != "" {
-			t.Errorf("expected empty request_id, got %s", requestID)
+		if _, err := uuid.Parse(requestID); err != nil {
+			t.Errorf("expected generated UUID request_id, got %q", requestID)
 		}
 
 		userID := ctx.Value(ContextKeyUserID)
@@ -158,7 +160,11 @@ func TestIdentity_PartialHeaders(t *testing.T) {
 				}
 
 				requestID := GetRequestID(ctx)
-				if requestID != tt.expectRequestID {
+				if tt.expectRequestID == "" {
+					if requestID == "" {
+						t.Error("expected a generated request_id")
+					}
+				} else if requestID != tt.expectRequestID {
 					t.Errorf("expected request_id=%s, got %s", tt.expectRequestID, requestID)
 				}
 
@@ -180,6 +186,52 @@ func TestIdentity_PartialHeaders(t *testing.T) {
 	}
 }
 
+func TestIdentity_RequestIDPropagation(t *testing.T) {
+	tests := []struct {
+		name            string
+		headerRequestID string
+	}{
+		{
+			name:            "caller-supplied request ID",
+			headerRequestID: "test-request-123",
+		},
+		{
+			name:            "generated request ID",
+			headerRequestID: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ctxRequestID string
+			handler := Identity(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				ctxRequestID = GetRequestID(r.Context())
+				w.WriteHeader(http.StatusOK)
+			}))
+
+			req := httptest.NewRequest(http.MethodGet, "/test", nil)
+			if tt.headerRequestID != "" {
+				req.Header.Set(HeaderRequestID, tt.headerRequestID)
+			}
+
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req)
+
+			if tt.headerRequestID != "" {
+				if ctxRequestID != tt.headerRequestID {
+					t.Errorf("expected request_id=%s, got %s", tt.headerRequestID, ctxRequestID)
+				}
+			} else if _, err := uuid.Parse(ctxRequestID); err != nil {
+				t.Errorf("expected generated UUID request_id, got %q", ctxRequestID)
+			}
+
+			if echoed := w.Header().Get(HeaderRequestID); echoed != ctxRequestID {
+				t.Errorf("expected response header %s=%s, got %s", HeaderRequestID, ctxRequestID, echoed)
+			}
+		})
+	}
+}
+
 func TestIdentity_EmptyHeaderValues(t *testing.T) {
 	handler := Identity(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
@@ -0,0 +1,98 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRequestLogger(t *testing.T) {
+	tests := []struct {
+		name          string
+		handler       http.HandlerFunc
+		expectedCode  int
+		expectedBytes int
+		expectedLevel string
+	}{
+		{
+			name: "success",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte("hello"))
+			},
+			expectedCode:  http.StatusOK,
+			expectedBytes: 5,
+			expectedLevel: "INFO",
+		},
+		{
+			name: "client error",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+				w.Write([]byte("not found"))
+			},
+			expectedCode:  http.StatusNotFound,
+			expectedBytes: 9,
+			expectedLevel: "INFO",
+		},
+		{
+			name: "server error",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusBadGateway)
+			},
+			expectedCode:  http.StatusBadGateway,
+			expectedBytes: 0,
+			expectedLevel: "WARN",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var logs bytes.Buffer
+			logger := slog.New(slog.NewJSONHandler(&logs, nil))
+
+			handler := RequestLogger(logger)(tt.handler)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v0/work", nil)
+			ctx := req.Context()
+			ctx = context.WithValue(ctx, ContextKeyAccountID, "123456789012")
+			ctx = context.WithValue(ctx, ContextKeyCallerARN, "arn:aws:iam::123456789012:user/test")
+			ctx = context.WithValue(ctx, ContextKeyRequestID, "req-123")
+			req = req.WithContext(ctx)
+
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req)
+
+			if w.Code != tt.expectedCode {
+				t.Errorf("expected response status %d, got %d", tt.expectedCode, w.Code)
+			}
+
+			var entry map[string]interface{}
+			if err := json.Unmarshal(logs.Bytes(), &entry); err != nil {
+				t.Fatalf("failed to decode log entry: %v", err)
+			}
+
+			expected := map[string]interface{}{
+				"level":      tt.expectedLevel,
+				"method":     http.MethodPost,
+				"path":       "/api/v0/work",
+				"status":     float64(tt.expectedCode),
+				"bytes":      float64(tt.expectedBytes),
+				"account_id": "123456789012",
+				"caller_arn": "arn:aws:iam::123456789012:user/test",
+				"request_id": "req-123",
+			}
+			for key, value := range expected {
+				if entry[key] != value {
+					t.Errorf("expected %s=%v, got %v", key, value, entry[key])
+				}
+			}
+
+			if _, ok := entry["duration"]; !ok {
+				t.Error("expected duration in log entry")
+			}
+		})
+	}
+}
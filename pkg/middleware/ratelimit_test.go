@@ -0,0 +1,167 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+)
+
+func newTestRateLimiter(rate float64, burst int, idleTimeout time.Duration) (*RateLimiter, *time.Time) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	rl := NewRateLimiter(rate, burst, idleTimeout, logger)
+
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	rl.now = func() time.Time { return now }
+	rl.lastSweep = now
+
+	return rl, &now
+}
+
+func rateLimitedRequest(handler http.Handler, accountID string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, "/test", nil)
+	if accountID != "" {
+		ctx := context.WithValue(req.Context(), ContextKeyAccountID, accountID)
+		req = req.WithContext(ctx)
+	}
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	return w
+}
+
+func TestRateLimiter_BurstThenReject(t *testing.T) {
+	rl, _ := newTestRateLimiter(1, 3, time.Minute)
+	handler := rl.RateLimit(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	for i := 0; i < 3; i++ {
+		if w := rateLimitedRequest(handler, "123456789012"); w.Code != http.StatusOK {
+			t.Fatalf("request %d: expected status 200, got %d", i, w.Code)
+		}
+	}
+
+	w := rateLimitedRequest(handler, "123456789012")
+	if w.Code != http.StatusTooManyRequests {
+		t.Fatalf("expected status 429, got %d", w.Code)
+	}
+
+	if retryAfter := w.Header().Get("Retry-After"); retryAfter != "1" {
+		t.Errorf("expected Retry-After=1, got %q", retryAfter)
+	}
+
+	var errorResp map[string]interface{}
+	if err := json.NewDecoder(w.Body).Decode(&errorResp); err != nil {
+		t.Fatalf("failed to decode error response: %v", err)
+	}
+
+	if errorResp["kind"] != "Error" {
+		t.Errorf("expected kind=Error, got %v", errorResp["kind"])
+	}
+
+	if errorResp["code"] != "rate-limit-exceeded" {
+		t.Errorf("expected code=rate-limit-exceeded, got %v", errorResp["code"])
+	}
+}
+
+func TestRateLimiter_Refill(t *testing.T) {
+	rl, now := newTestRateLimiter(2, 1, time.Minute)
+	handler := rl.RateLimit(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	if w := rateLimitedRequest(handler, "123456789012"); w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", w.Code)
+	}
+
+	if w := rateLimitedRequest(handler, "123456789012"); w.Code != http.StatusTooManyRequests {
+		t.Fatalf("expected status 429, got %d", w.Code)
+	}
+
+	*now = now.Add(500 * time.Millisecond)
+
+	if w := rateLimitedRequest(handler, "123456789012"); w.Code != http.StatusOK {
+		t.Errorf("expected status 200 after refill, got %d", w.Code)
+	}
+}
+
+func TestRateLimiter_PerAccount(t *testing.T) {
+	rl, _ := newTestRateLimiter(1, 1, time.Minute)
+	handler := rl.RateLimit(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	if w := rateLimitedRequest(handler, "123456789012"); w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", w.Code)
+	}
+
+	if w := rateLimitedRequest(handler, "123456789012"); w.Code != http.StatusTooManyRequests {
+		t.Errorf("expected status 429, got %d", w.Code)
+	}
+
+	if w := rateLimitedRequest(handler, "987654321098"); w.Code != http.StatusOK {
+		t.Errorf("expected status 200 for a different account, got %d", w.Code)
+	}
+}
+
+func TestRateLimiter_MissingAccountID(t *testing.T) {
+	rl, _ := newTestRateLimiter(1, 1, time.Minute)
+	handler := rl.RateLimit(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	for i := 0; i < 3; i++ {
+		if w := rateLimitedRequest(handler, ""); w.Code != http.StatusOK {
+			t.Errorf("request %d: expected status 200, got %d", i, w.Code)
+		}
+	}
+
+	if len(rl.buckets) != 0 {
+		t.Errorf("expected no buckets, got %d", len(rl.buckets))
+	}
+}
+
+func TestRateLimiter_InvalidAccountID(t *testing.T) {
+	rl, _ := newTestRateLimiter(1, 1, time.Minute)
+	handler := rl.RateLimit(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	for _, accountID := range []string{"not-an-account", "12345", "1234567890123"} {
+		rateLimitedRequest(handler, accountID)
+	}
+
+	if len(rl.buckets) != 0 {
+		t.Errorf("expected no buckets for invalid account IDs, got %d", len(rl.buckets))
+	}
+}
+
+func TestRateLimiter_IdleEviction(t *testing.T) {
+	rl, now := newTestRateLimiter(1, 1, time.Minute)
+	handler := rl.RateLimit(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	rateLimitedRequest(handler, "123456789012")
+	rateLimitedRequest(handler, "987654321098")
+
+	if len(rl.buckets) != 2 {
+		t.Fatalf("expected 2 buckets, got %d", len(rl.buckets))
+	}
+
+	*now = now.Add(2 * time.Minute)
+	rateLimitedRequest(handler, "111111111111")
+
+	if len(rl.buckets) != 1 {
+		t.Errorf("expected idle buckets to be evicted, got %d buckets", len(rl.buckets))
+	}
+
+	if _, ok := rl.buckets["111111111111"]; !ok {
+		t.Error("expected bucket for the active account")
+	}
+}
@@ -0,0 +1,38 @@
+package middleware
+
+import (
+	"log/slog"
+	"net/http"
+	"runtime/debug"
+)
+
+// Recover returns middleware that recovers from panics in downstream handlers,
+// logs the panic with its stack trace, and responds with a 500 error envelope
+func Recover(logger *slog.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer func() {
+				rec := recover()
+				if rec == nil {
+					return
+				}
+				// http.ErrAbortHandler is used to deliberately abort a response
+				if rec == http.ErrAbortHandler {
+					panic(rec)
+				}
+
+				logger.Error("recovered from panic in handler",
+					"panic", rec,
+					"method", r.Method,
+					"path", r.URL.Path,
+					"request_id", GetRequestID(r.Context()),
+					"stack", string(debug.Stack()),
+				)
+
+				writeError(w, http.StatusInternalServerError, "internal-error", "internal server error")
+			}()
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -35,6 +35,10 @@ func New(cfg *config.Config, logger *slog.Logger) (*Server, error) {
 
 	// Create handlers
 	healthHandler := apphandlers.NewHealthHandler()
+	healthHandler.AddCheck("maestro", func(ctx context.Context) error {
+		_, err := maestroClient.ListConsumers(ctx, 1, 1)
+		return err
+	})
 	mgmtClusterHandler := apphandlers.NewManagementClusterHandler(maestroClient, logger)
 	resourceBundleHandler := apphandlers.NewResourceBundleHandler(maestroClient, logger)
 	workHandler := apphandlers.NewWorkHandler(maestroClient, logger)
@@ -45,6 +49,12 @@ func New(cfg *config.Config, logger *slog.Logger) (*Server, error) {
 	// Create API router
 	apiRouter := mux.NewRouter()
 	apiRouter.Use(middleware.Identity)
+	apiRouter.Use(middleware.RequestLogger(logger))
+	apiRouter.Use(middleware.Recover(logger))
+	if cfg.RateLimit.RequestsPerSecond > 0 {
+		rateLimiter := middleware.NewRateLimiter(cfg.RateLimit.RequestsPerSecond, cfg.RateLimit.Burst, cfg.RateLimit.IdleTimeout, logger)
+		apiRouter.Use(rateLimiter.RateLimit)
+	}
 
 	// Management cluster routes (require allowed account)
 	mgmtRouter := apiRouter.PathPrefix("/api/v0/management_clusters").Subrouter()
@@ -13,6 +13,18 @@ import (
 	"github.com/openshift/rosa-regional-frontend-api/pkg/middleware"
 )
 
+// newFakeMaestro starts a Maestro stub that answers consumer listings, so the
+// readiness check passes
+func newFakeMaestro(t *testing.T) *httptest.Server {
+	t.Helper()
+	fake := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"kind":"ConsumerList","page":1,"size":0,"total":0,"items":[]}`))
+	}))
+	t.Cleanup(fake.Close)
+	return fake
+}
+
 func TestNew(t *testing.T) {
 	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
 	cfg := config.NewConfig()
@@ -95,6 +107,7 @@ func TestNew_WithCustomConfig(t *testing.T) {
 func TestServer_HealthRoutes(t *testing.T) {
 	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
 	cfg := config.NewConfig()
+	cfg.Maestro.BaseURL = newFakeMaestro(t).URL
 
 	server, err := New(cfg, logger)
 	if err != nil {
@@ -301,6 +314,7 @@ func TestServer_MetricsRoute(t *testing.T) {
 func TestServer_HealthServerRoutes(t *testing.T) {
 	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
 	cfg := config.NewConfig()
+	cfg.Maestro.BaseURL = newFakeMaestro(t).URL
 
 	server, err := New(cfg, logger)
 	if err != nil {
@@ -386,6 +400,7 @@ func TestServer_InvalidRoutes(t *testing.T) {
 func TestServer_ReadinessToggle(t *testing.T) {
 	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
 	cfg := config.NewConfig()
+	cfg.Maestro.BaseURL = newFakeMaestro(t).URL
 
 	server, err := New(cfg, logger)
 	if err != nil {
@@ -424,6 +439,37 @@ func TestServer_ReadinessToggle(t *testing.T) {
 	}
 }
 
+func TestServer_ReadinessReflectsMaestro(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	fake := newFakeMaestro(t)
+	cfg := config.NewConfig()
+	cfg.Maestro.BaseURL = fake.URL
+	cfg.Maestro.RetryMaxAttempts = 1
+
+	server, err := New(cfg, logger)
+	if err != nil {
+		t.Fatalf("unexpected error creating server: %v", err)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/readyz", nil)
+	w := httptest.NewRecorder()
+	server.healthServer.Handler.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("expected status 200 while Maestro is reachable, got %d", w.Code)
+	}
+
+	fake.Close()
+
+	req = httptest.NewRequest(http.MethodGet, "/readyz", nil)
+	w = httptest.NewRecorder()
+	server.healthServer.Handler.ServeHTTP(w, req)
+
+	if w.Code != http.StatusServiceUnavailable {
+		t.Errorf("expected status 503 while Maestro is unreachable, got %d", w.Code)
+	}
+}
+
 func TestServer_ServerAddresses(t *testing.T) {
 	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
 	cfg := &config.Config{